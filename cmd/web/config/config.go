@@ -2,7 +2,11 @@ package config
 
 import (
 	"fmt"
+	"net"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -10,6 +14,50 @@ import (
 type Config struct {
 	Host string
 	Port string
+
+	// Due-date reminders
+	ReminderInterval  time.Duration
+	ReminderWindow    time.Duration
+	EscalateAfterDays int
+
+	// Recurring tasks
+	RecurrenceInterval time.Duration
+
+	// Archiving of done tasks
+	ArchiveInterval  time.Duration
+	ArchiveAfterDays int
+
+	// Trash
+	TrashPurgeInterval time.Duration
+	TrashRetentionDays int
+
+	// Idempotency keys
+	IdempotencyTTL time.Duration
+
+	// Rate limits, per IP for the auth routes and per user for the rest of the API
+	AuthRateLimit  int
+	AuthRateWindow time.Duration
+	APIRateLimit   int
+	APIRateWindow  time.Duration
+
+	// Proxies whose X-Forwarded-For header is trusted for the client IP, none when empty
+	TrustedProxies []string
+
+	// Login lockout
+	LoginMaxAttempts        int
+	LoginLockoutDuration    time.Duration
+	LoginLockoutMaxDuration time.Duration
+	LoginIPMaxAttempts      int
+	LoginIPWindow           time.Duration
+
+	// Password reset and email verification
+	PasswordResetTTL     time.Duration
+	EmailVerificationTTL time.Duration
+
+	// Attachments
+	AttachmentMaxSize       int64
+	AttachmentLinkTTL       time.Duration
+	AttachmentSigningSecret string
 }
 
 func LoadConfigFromEnv() (Config, error) {
@@ -27,10 +75,217 @@ func LoadConfigFromEnv() (Config, error) {
 	}
 
 	config := Config{
-		Host: host,
-		Port: port,
+		Host:                    host,
+		Port:                    port,
+		ReminderInterval:        15 * time.Minute, // Default: check every 15 minutes
+		ReminderWindow:          24 * time.Hour,   // Default: remind a day before the due date
+		EscalateAfterDays:       3,                // Default: escalate after 3 days overdue
+		RecurrenceInterval:      time.Minute,      // Default: generate occurrences every minute
+		ArchiveInterval:         time.Hour,        // Default: archive done tasks every hour
+		ArchiveAfterDays:        14,               // Default: archive tasks done for 14 days
+		TrashPurgeInterval:      time.Hour,        // Default: purge the trash every hour
+		TrashRetentionDays:      30,               // Default: keep deleted tasks for 30 days
+		IdempotencyTTL:          24 * time.Hour,   // Default: replay responses of idempotent requests for a day
+		AuthRateLimit:           10,               // Default: 10 login or refresh attempts
+		AuthRateWindow:          time.Minute,      // per minute
+		APIRateLimit:            300,              // Default: 300 requests
+		APIRateWindow:           time.Minute,      // per minute
+		LoginMaxAttempts:        5,                // Default: lock an account after 5 failed logins in a row
+		LoginLockoutDuration:    5 * time.Minute,  // for 5 minutes, doubled with every further lockout
+		LoginLockoutMaxDuration: 24 * time.Hour,   // up to a day
+		LoginIPMaxAttempts:      20,               // Default: block an IP after 20 failed logins
+		LoginIPWindow:           15 * time.Minute, // within 15 minutes
+		PasswordResetTTL:        time.Hour,        // Default: reset tokens are valid for an hour
+		EmailVerificationTTL:    24 * time.Hour,   // Default: verification tokens are valid for a day
+		AttachmentMaxSize:       10 << 20,         // Default: 10MB per file
+		AttachmentLinkTTL:       15 * time.Minute, // Default: download links are valid for 15 minutes
 	}
 
+	if interval := os.Getenv("REMINDER_INTERVAL"); interval != "" {
+		duration, err := time.ParseDuration(interval)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid REMINDER_INTERVAL format: %w", err)
+		}
+		config.ReminderInterval = duration
+	}
+
+	if window := os.Getenv("REMINDER_WINDOW"); window != "" {
+		duration, err := time.ParseDuration(window)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid REMINDER_WINDOW format: %w", err)
+		}
+		config.ReminderWindow = duration
+	}
+
+	if days := os.Getenv("REMINDER_ESCALATE_AFTER_DAYS"); days != "" {
+		value, err := strconv.Atoi(days)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid REMINDER_ESCALATE_AFTER_DAYS format: %w", err)
+		}
+		config.EscalateAfterDays = value
+	}
+
+	if interval := os.Getenv("RECURRENCE_INTERVAL"); interval != "" {
+		duration, err := time.ParseDuration(interval)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid RECURRENCE_INTERVAL format: %w", err)
+		}
+		config.RecurrenceInterval = duration
+	}
+
+	if interval := os.Getenv("ARCHIVE_INTERVAL"); interval != "" {
+		duration, err := time.ParseDuration(interval)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid ARCHIVE_INTERVAL format: %w", err)
+		}
+		config.ArchiveInterval = duration
+	}
+
+	if days := os.Getenv("ARCHIVE_AFTER_DAYS"); days != "" {
+		value, err := strconv.Atoi(days)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid ARCHIVE_AFTER_DAYS format: %w", err)
+		}
+		config.ArchiveAfterDays = value
+	}
+
+	if interval := os.Getenv("TRASH_PURGE_INTERVAL"); interval != "" {
+		duration, err := time.ParseDuration(interval)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid TRASH_PURGE_INTERVAL format: %w", err)
+		}
+		config.TrashPurgeInterval = duration
+	}
+
+	if days := os.Getenv("TRASH_RETENTION_DAYS"); days != "" {
+		value, err := strconv.Atoi(days)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid TRASH_RETENTION_DAYS format: %w", err)
+		}
+		config.TrashRetentionDays = value
+	}
+
+	if ttl := os.Getenv("IDEMPOTENCY_TTL"); ttl != "" {
+		duration, err := time.ParseDuration(ttl)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid IDEMPOTENCY_TTL format: %w", err)
+		}
+		config.IdempotencyTTL = duration
+	}
+
+	if requests := os.Getenv("RATE_LIMIT_AUTH_REQUESTS"); requests != "" {
+		value, err := strconv.Atoi(requests)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid RATE_LIMIT_AUTH_REQUESTS format: %w", err)
+		}
+		config.AuthRateLimit = value
+	}
+
+	if window := os.Getenv("RATE_LIMIT_AUTH_WINDOW"); window != "" {
+		duration, err := time.ParseDuration(window)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid RATE_LIMIT_AUTH_WINDOW format: %w", err)
+		}
+		config.AuthRateWindow = duration
+	}
+
+	if requests := os.Getenv("RATE_LIMIT_API_REQUESTS"); requests != "" {
+		value, err := strconv.Atoi(requests)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid RATE_LIMIT_API_REQUESTS format: %w", err)
+		}
+		config.APIRateLimit = value
+	}
+
+	if window := os.Getenv("RATE_LIMIT_API_WINDOW"); window != "" {
+		duration, err := time.ParseDuration(window)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid RATE_LIMIT_API_WINDOW format: %w", err)
+		}
+		config.APIRateWindow = duration
+	}
+
+	// Comma separated IPs or CIDRs, e.g. 10.0.0.0/8,192.168.1.10
+	for _, proxy := range strings.Split(os.Getenv("TRUSTED_PROXIES"), ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			config.TrustedProxies = append(config.TrustedProxies, proxy)
+		}
+	}
+
+	if attempts := os.Getenv("LOGIN_MAX_ATTEMPTS"); attempts != "" {
+		value, err := strconv.Atoi(attempts)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid LOGIN_MAX_ATTEMPTS format: %w", err)
+		}
+		config.LoginMaxAttempts = value
+	}
+
+	if duration := os.Getenv("LOGIN_LOCKOUT_DURATION"); duration != "" {
+		value, err := time.ParseDuration(duration)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid LOGIN_LOCKOUT_DURATION format: %w", err)
+		}
+		config.LoginLockoutDuration = value
+	}
+
+	if duration := os.Getenv("LOGIN_LOCKOUT_MAX_DURATION"); duration != "" {
+		value, err := time.ParseDuration(duration)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid LOGIN_LOCKOUT_MAX_DURATION format: %w", err)
+		}
+		config.LoginLockoutMaxDuration = value
+	}
+
+	if attempts := os.Getenv("LOGIN_IP_MAX_ATTEMPTS"); attempts != "" {
+		value, err := strconv.Atoi(attempts)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid LOGIN_IP_MAX_ATTEMPTS format: %w", err)
+		}
+		config.LoginIPMaxAttempts = value
+	}
+
+	if duration := os.Getenv("LOGIN_IP_WINDOW"); duration != "" {
+		value, err := time.ParseDuration(duration)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid LOGIN_IP_WINDOW format: %w", err)
+		}
+		config.LoginIPWindow = value
+	}
+
+	if duration := os.Getenv("PASSWORD_RESET_TTL"); duration != "" {
+		value, err := time.ParseDuration(duration)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid PASSWORD_RESET_TTL format: %w", err)
+		}
+		config.PasswordResetTTL = value
+	}
+
+	if duration := os.Getenv("EMAIL_VERIFICATION_TTL"); duration != "" {
+		value, err := time.ParseDuration(duration)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid EMAIL_VERIFICATION_TTL format: %w", err)
+		}
+		config.EmailVerificationTTL = value
+	}
+
+	if size := os.Getenv("ATTACHMENT_MAX_SIZE"); size != "" {
+		value, err := strconv.ParseInt(size, 10, 64)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid ATTACHMENT_MAX_SIZE format: %w", err)
+		}
+		config.AttachmentMaxSize = value
+	}
+
+	if ttl := os.Getenv("ATTACHMENT_LINK_TTL"); ttl != "" {
+		duration, err := time.ParseDuration(ttl)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid ATTACHMENT_LINK_TTL format: %w", err)
+		}
+		config.AttachmentLinkTTL = duration
+	}
+
+	config.AttachmentSigningSecret = os.Getenv("ATTACHMENT_SIGNING_SECRET")
+
 	return config, nil
 }
 
@@ -51,5 +306,89 @@ func ValidateConfig(config Config) error {
 		return fmt.Errorf("port is required")
 	}
 
+	if config.ReminderInterval <= 0 {
+		return fmt.Errorf("reminder interval must be positive")
+	}
+
+	if config.ReminderWindow < 0 {
+		return fmt.Errorf("reminder window cannot be negative")
+	}
+
+	if config.EscalateAfterDays < 0 {
+		return fmt.Errorf("escalate after days cannot be negative")
+	}
+
+	if config.RecurrenceInterval <= 0 {
+		return fmt.Errorf("recurrence interval must be positive")
+	}
+
+	if config.ArchiveInterval <= 0 {
+		return fmt.Errorf("archive interval must be positive")
+	}
+
+	if config.ArchiveAfterDays < 0 {
+		return fmt.Errorf("archive after days cannot be negative")
+	}
+
+	if config.TrashPurgeInterval <= 0 {
+		return fmt.Errorf("trash purge interval must be positive")
+	}
+
+	if config.TrashRetentionDays <= 0 {
+		return fmt.Errorf("trash retention days must be positive")
+	}
+
+	if config.IdempotencyTTL <= 0 {
+		return fmt.Errorf("idempotency TTL must be positive")
+	}
+
+	if config.AuthRateLimit <= 0 || config.APIRateLimit <= 0 {
+		return fmt.Errorf("rate limits must be positive")
+	}
+
+	if config.AuthRateWindow <= 0 || config.APIRateWindow <= 0 {
+		return fmt.Errorf("rate limit windows must be positive")
+	}
+
+	for _, proxy := range config.TrustedProxies {
+		if net.ParseIP(proxy) == nil {
+			if _, _, err := net.ParseCIDR(proxy); err != nil {
+				return fmt.Errorf("invalid trusted proxy %q, it must be an IP or CIDR", proxy)
+			}
+		}
+	}
+
+	if config.LoginMaxAttempts <= 0 || config.LoginIPMaxAttempts <= 0 {
+		return fmt.Errorf("login max attempts must be positive")
+	}
+
+	if config.LoginLockoutDuration <= 0 || config.LoginLockoutMaxDuration < config.LoginLockoutDuration {
+		return fmt.Errorf("login lockout duration must be positive and not exceed the max duration")
+	}
+
+	if config.LoginIPWindow <= 0 {
+		return fmt.Errorf("login IP window must be positive")
+	}
+
+	if config.PasswordResetTTL <= 0 {
+		return fmt.Errorf("password reset TTL must be positive")
+	}
+
+	if config.EmailVerificationTTL <= 0 {
+		return fmt.Errorf("email verification TTL must be positive")
+	}
+
+	if config.AttachmentMaxSize <= 0 {
+		return fmt.Errorf("attachment max size must be positive")
+	}
+
+	if config.AttachmentLinkTTL <= 0 {
+		return fmt.Errorf("attachment link TTL must be positive")
+	}
+
+	if len(config.AttachmentSigningSecret) < 32 {
+		return fmt.Errorf("attachment signing secret must be at least 32 characters long")
+	}
+
 	return nil
 }
@@ -5,11 +5,21 @@ import (
 	"task_mng/cmd/web/config"
 	"task_mng/interfaces/http/server"
 	"task_mng/pkg/jwt"
+	"task_mng/pkg/notifier"
 	"task_mng/pkg/postgres"
 	"task_mng/pkg/redis"
+	"task_mng/pkg/storage"
 
+	attachmentE "task_mng/domain/attachment/entity"
+	checklistE "task_mng/domain/checklist/entity"
+	projectE "task_mng/domain/project/entity"
+	recurrenceE "task_mng/domain/recurrence/entity"
+	sprintE "task_mng/domain/sprint/entity"
+	taskE "task_mng/domain/task/entity"
+	templateE "task_mng/domain/template/entity"
 	userR "task_mng/domain/user"
 	userE "task_mng/domain/user/entity"
+	worklogE "task_mng/domain/worklog/entity"
 	userS "task_mng/services/user"
 )
 
@@ -43,9 +53,21 @@ func main() {
 		return
 	}
 
+	storage := initializeStorage()
+	if storage == nil {
+		fmt.Println("Failed to initialize storage, exiting...")
+		return
+	}
+
+	notifier := initializeNotifier(postgres)
+	if notifier == nil {
+		fmt.Println("Failed to initialize notifier, exiting...")
+		return
+	}
+
 	migrateDatabase(postgres)
 
-	srv := server.New(&cfg, jwtManager, postgres, redis)
+	srv := server.New(&cfg, jwtManager, postgres, redis, storage, notifier)
 
 	if err := srv.Start(); err != nil {
 		fmt.Printf("Failed to start server: %v\n", err)
@@ -119,15 +141,108 @@ func initializeRedis() *redis.Redis {
 	return redisClient
 }
 
+// Initialize Storage
+func initializeStorage() storage.Storage {
+	config, err := storage.LoadConfigFromEnv()
+	if err != nil {
+		fmt.Printf("Failed to load storage config: %v\n", err.Error())
+		return nil
+	}
+
+	if err := storage.ValidateConfig(config); err != nil {
+		fmt.Printf("Failed to validate storage config: %v\n", err.Error())
+		return nil
+	}
+
+	store, err := storage.New(config)
+	if err != nil {
+		fmt.Printf("Failed to initialize storage: %v\n", err.Error())
+		return nil
+	}
+
+	fmt.Println("Storage initialized")
+
+	return store
+}
+
+// Initialize Notifier
+func initializeNotifier(postgres *postgres.Database) notifier.Notifier {
+	config, err := notifier.LoadConfigFromEnv()
+	if err != nil {
+		fmt.Printf("Failed to load notifier config: %v\n", err.Error())
+		return nil
+	}
+
+	if err := notifier.ValidateConfig(config); err != nil {
+		fmt.Printf("Failed to validate notifier config: %v\n", err.Error())
+		return nil
+	}
+
+	userRepo := userR.New(postgres)
+	lookup := func(userID uint) (string, error) {
+		usr, err := userRepo.FindByID(userID)
+		return usr.Email, err
+	}
+
+	n, err := notifier.New(config, lookup)
+	if err != nil {
+		fmt.Printf("Failed to initialize notifier: %v\n", err.Error())
+		return nil
+	}
+
+	if config.Driver == notifier.DriverLog {
+		fmt.Println("Notifier initialized, password reset and verification tokens can't be delivered by the log driver")
+	} else {
+		fmt.Println("Notifier initialized")
+	}
+
+	return n
+}
+
 func migrateDatabase(postgres *postgres.Database) {
-	if err := postgres.DB.AutoMigrate(&userE.User{}); err != nil {
+	if err := postgres.DB.AutoMigrate(
+		&userE.User{},
+		&userE.LockoutEvent{},
+		&userE.PasswordResetToken{},
+		&userE.EmailVerificationToken{},
+		&userE.AccessToken{},
+		&taskE.Task{},
+		&taskE.History{},
+		&taskE.Column{},
+		&taskE.Watcher{},
+		&taskE.Mention{},
+		&recurrenceE.Recurrence{},
+		&templateE.Template{},
+		&checklistE.Item{},
+		&attachmentE.Attachment{},
+		&worklogE.Worklog{},
+		&sprintE.Sprint{},
+		&projectE.Project{},
+		&projectE.Field{},
+	); err != nil {
 		fmt.Printf("Failed to migrate tables: %v\n", err)
 		return
 	}
 
-	// insert default user
+	// emails are unique regardless of case, deleted users don't hold on to their address
+	if err := postgres.DB.Exec(
+		"CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email_lower ON users (LOWER(email)) WHERE deleted_at IS NULL",
+	).Error; err != nil {
+		fmt.Printf("Failed to create unique email index, users sharing an email have to be fixed first: %v\n", err)
+		return
+	}
+
+	// template names are unique, deleted templates don't hold on to their name
+	if err := postgres.DB.Exec(
+		"CREATE UNIQUE INDEX IF NOT EXISTS idx_templates_name ON templates (name) WHERE deleted_at IS NULL",
+	).Error; err != nil {
+		fmt.Printf("Failed to create unique template name index: %v\n", err)
+		return
+	}
+
+	// insert default user, no verification is sent for it
 	userRepo := userR.New(postgres)
-	userService := userS.New(userRepo, nil)
+	userService := userS.New(userRepo, nil, nil, nil, nil, userS.Config{})
 
 	userService.Create(&userS.CreateRequest{
 		Username: "admin",
@@ -136,5 +251,13 @@ func migrateDatabase(postgres *postgres.Database) {
 		Password: "Admin!123",
 	})
 
+	// the default user manages the instance, e.g. permanently deleting tasks
+	if admin, err := userRepo.FindByUsername("admin"); err == nil && admin.Role != userE.RoleAdmin {
+		admin.Role = userE.RoleAdmin
+		if err := userRepo.Update(admin); err != nil {
+			fmt.Printf("Failed to promote default user: %v\n", err)
+		}
+	}
+
 	fmt.Println("Migrating tables completed")
 }
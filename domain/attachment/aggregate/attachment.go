@@ -0,0 +1,32 @@
+package aggregate
+
+import (
+	"task_mng/domain/attachment/entity"
+	"time"
+)
+
+type AttachmentResponse struct {
+	ID          uint      `json:"id"`
+	TaskID      uint      `json:"task_id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	UploadedBy  uint      `json:"uploaded_by"`
+	DownloadURL string    `json:"download_url"`
+	ExpiresAt   time.Time `json:"download_url_expires_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func NewAttachmentResponse(attachment *entity.Attachment, downloadURL string, expiresAt time.Time) *AttachmentResponse {
+	return &AttachmentResponse{
+		ID:          attachment.ID,
+		TaskID:      attachment.TaskID,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		UploadedBy:  attachment.UploadedBy,
+		DownloadURL: downloadURL,
+		ExpiresAt:   expiresAt,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
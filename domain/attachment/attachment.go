@@ -0,0 +1,34 @@
+package attachment
+
+import (
+	"task_mng/domain/attachment/entity"
+	"task_mng/pkg/postgres"
+)
+
+type repository struct {
+	db *postgres.Database
+}
+
+func New(db *postgres.Database) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Create(e *entity.Attachment) error {
+	return r.db.Create(e).Error
+}
+
+func (r *repository) FindByID(id uint) (entity.Attachment, error) {
+	var attachment entity.Attachment
+	err := r.db.Where("id = ?", id).First(&attachment).Error
+	return attachment, err
+}
+
+func (r *repository) FindByTaskID(taskID uint) ([]entity.Attachment, error) {
+	var attachments []entity.Attachment
+	err := r.db.Where("task_id = ?", taskID).Order("created_at ASC").Find(&attachments).Error
+	return attachments, err
+}
+
+func (r *repository) Delete(e entity.Attachment) error {
+	return r.db.Delete(&e).Error
+}
@@ -0,0 +1,17 @@
+package entity
+
+import "gorm.io/gorm"
+
+type Attachment struct {
+	gorm.Model
+	TaskID      uint   `gorm:"not null;index"`
+	FileName    string `gorm:"not null"`
+	ContentType string `gorm:"not null"`
+	Size        int64  `gorm:"not null"`
+	StorageKey  string `gorm:"not null;uniqueIndex"`
+	UploadedBy  uint   `gorm:"not null"`
+}
+
+func (a *Attachment) TableName() string {
+	return "attachments"
+}
@@ -0,0 +1,31 @@
+package mocks
+
+import (
+	"task_mng/domain/attachment/entity"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAttachmentRepository struct {
+	mock.Mock
+}
+
+func (m *MockAttachmentRepository) Create(e *entity.Attachment) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockAttachmentRepository) FindByID(id uint) (entity.Attachment, error) {
+	args := m.Called(id)
+	return args.Get(0).(entity.Attachment), args.Error(1)
+}
+
+func (m *MockAttachmentRepository) FindByTaskID(taskID uint) ([]entity.Attachment, error) {
+	args := m.Called(taskID)
+	return args.Get(0).([]entity.Attachment), args.Error(1)
+}
+
+func (m *MockAttachmentRepository) Delete(e entity.Attachment) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
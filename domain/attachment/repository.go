@@ -0,0 +1,10 @@
+package attachment
+
+import "task_mng/domain/attachment/entity"
+
+type Repository interface {
+	Create(e *entity.Attachment) error
+	FindByID(id uint) (entity.Attachment, error)
+	FindByTaskID(taskID uint) ([]entity.Attachment, error)
+	Delete(e entity.Attachment) error
+}
@@ -0,0 +1,49 @@
+package aggregate
+
+import (
+	"task_mng/domain/checklist/entity"
+	taskAggregate "task_mng/domain/task/aggregate"
+	"time"
+)
+
+type ItemResponse struct {
+	ID        uint                        `json:"id"`
+	TaskID    uint                        `json:"task_id"`
+	Text      string                      `json:"text"`
+	Done      bool                        `json:"done"`
+	Assignee  *taskAggregate.AssigneeInfo `json:"assignee"`
+	Position  int                         `json:"position"`
+	CreatedAt time.Time                   `json:"created_at"`
+}
+
+func NewItemResponse(item *entity.Item, assigneeUsername string) *ItemResponse {
+	var assignee *taskAggregate.AssigneeInfo
+	if item.Assignee != nil {
+		assignee = &taskAggregate.AssigneeInfo{
+			ID:       *item.Assignee,
+			Username: assigneeUsername,
+		}
+	}
+
+	return &ItemResponse{
+		ID:        item.ID,
+		TaskID:    item.TaskID,
+		Text:      item.Text,
+		Done:      item.Done,
+		Assignee:  assignee,
+		Position:  item.Position,
+		CreatedAt: item.CreatedAt,
+	}
+}
+
+func NewItemListResponse(items []entity.Item, assigneeUsernames map[uint]string) []*ItemResponse {
+	itemResponses := make([]*ItemResponse, len(items))
+	for i, item := range items {
+		username := ""
+		if item.Assignee != nil {
+			username = assigneeUsernames[*item.Assignee]
+		}
+		itemResponses[i] = NewItemResponse(&item, username)
+	}
+	return itemResponses
+}
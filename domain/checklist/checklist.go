@@ -0,0 +1,62 @@
+package checklist
+
+import (
+	"task_mng/domain/checklist/entity"
+	"task_mng/pkg/postgres"
+
+	"gorm.io/gorm"
+)
+
+type repository struct {
+	db *postgres.Database
+}
+
+func New(db *postgres.Database) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Create(e *entity.Item) error {
+	return r.db.Create(e).Error
+}
+
+func (r *repository) CreateMany(items []entity.Item) error {
+	if len(items) == 0 {
+		return nil
+	}
+	return r.db.Create(&items).Error
+}
+
+func (r *repository) Update(e entity.Item) error {
+	return r.db.Save(&e).Error
+}
+
+func (r *repository) FindByID(id uint) (entity.Item, error) {
+	var item entity.Item
+	err := r.db.Where("id = ?", id).First(&item).Error
+	return item, err
+}
+
+func (r *repository) FindByTaskID(taskID uint) ([]entity.Item, error) {
+	var items []entity.Item
+	err := r.db.Where("task_id = ?", taskID).Order("position ASC, id ASC").Find(&items).Error
+	return items, err
+}
+
+func (r *repository) Delete(e entity.Item) error {
+	return r.db.Delete(&e).Error
+}
+
+// Reorder sets the position of each item to its index in ids
+func (r *repository) Reorder(taskID uint, ids []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for position, id := range ids {
+			err := tx.Model(&entity.Item{}).
+				Where("id = ? AND task_id = ?", id, taskID).
+				Update("position", position).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
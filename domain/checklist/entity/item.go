@@ -0,0 +1,17 @@
+package entity
+
+import "gorm.io/gorm"
+
+// Item is a small step inside a task
+type Item struct {
+	gorm.Model
+	TaskID   uint   `gorm:"not null;index"`
+	Text     string `gorm:"not null"`
+	Done     bool   `gorm:"not null;default:false"`
+	Assignee *uint  // optional user id
+	Position int    `gorm:"not null;default:0"`
+}
+
+func (Item) TableName() string {
+	return "checklist_items"
+}
@@ -0,0 +1,46 @@
+package mocks
+
+import (
+	"task_mng/domain/checklist/entity"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockChecklistRepository struct {
+	mock.Mock
+}
+
+func (m *MockChecklistRepository) Create(e *entity.Item) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockChecklistRepository) CreateMany(items []entity.Item) error {
+	args := m.Called(items)
+	return args.Error(0)
+}
+
+func (m *MockChecklistRepository) Update(e entity.Item) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockChecklistRepository) FindByID(id uint) (entity.Item, error) {
+	args := m.Called(id)
+	return args.Get(0).(entity.Item), args.Error(1)
+}
+
+func (m *MockChecklistRepository) FindByTaskID(taskID uint) ([]entity.Item, error) {
+	args := m.Called(taskID)
+	return args.Get(0).([]entity.Item), args.Error(1)
+}
+
+func (m *MockChecklistRepository) Delete(e entity.Item) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockChecklistRepository) Reorder(taskID uint, ids []uint) error {
+	args := m.Called(taskID, ids)
+	return args.Error(0)
+}
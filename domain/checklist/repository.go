@@ -0,0 +1,13 @@
+package checklist
+
+import "task_mng/domain/checklist/entity"
+
+type Repository interface {
+	Create(e *entity.Item) error
+	CreateMany(items []entity.Item) error
+	Update(e entity.Item) error
+	FindByID(id uint) (entity.Item, error)
+	FindByTaskID(taskID uint) ([]entity.Item, error)
+	Delete(e entity.Item) error
+	Reorder(taskID uint, ids []uint) error
+}
@@ -0,0 +1,69 @@
+package aggregate
+
+import (
+	"task_mng/domain/project/entity"
+	"task_mng/pkg/response"
+	"time"
+)
+
+type ProjectResponse struct {
+	ID          uint      `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func NewProjectResponse(project *entity.Project) *ProjectResponse {
+	return &ProjectResponse{
+		ID:          project.ID,
+		Name:        project.Name,
+		Description: project.Description,
+		CreatedAt:   project.CreatedAt,
+	}
+}
+
+type ProjectListResponse struct {
+	Projects []*ProjectResponse `json:"projects"`
+	Meta     *response.Meta     `json:"-"`
+}
+
+func NewProjectListResponse(projects []entity.Project, page, limit int, count int64, sort string) *ProjectListResponse {
+	projectResponses := make([]*ProjectResponse, len(projects))
+	for i, project := range projects {
+		projectResponses[i] = NewProjectResponse(&project)
+	}
+	return &ProjectListResponse{
+		Projects: projectResponses,
+		Meta:     response.NewMeta(page, limit, int(count), sort),
+	}
+}
+
+type FieldResponse struct {
+	ID        uint             `json:"id"`
+	ProjectID uint             `json:"project_id"`
+	Key       string           `json:"key"`
+	Name      string           `json:"name"`
+	Type      entity.FieldType `json:"type"`
+	Options   []string         `json:"options,omitempty"`
+	Required  bool             `json:"required"`
+}
+
+func NewFieldResponse(field *entity.Field) *FieldResponse {
+	return &FieldResponse{
+		ID:        field.ID,
+		ProjectID: field.ProjectID,
+		Key:       field.Key,
+		Name:      field.Name,
+		Type:      field.Type,
+		Options:   field.Options,
+		Required:  field.Required,
+	}
+}
+
+func NewFieldListResponse(fields []entity.Field) []*FieldResponse {
+	result := make([]*FieldResponse, len(fields))
+	for i := range fields {
+		result[i] = NewFieldResponse(&fields[i])
+	}
+	return result
+}
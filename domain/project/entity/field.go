@@ -0,0 +1,116 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+type FieldType string
+
+const (
+	FieldTypeText        FieldType = "text"
+	FieldTypeNumber      FieldType = "number"
+	FieldTypeDate        FieldType = "date"
+	FieldTypeSelect      FieldType = "select"
+	FieldTypeMultiSelect FieldType = "multi_select"
+	FieldTypeUser        FieldType = "user" // stored as the user id
+)
+
+// DateLayout is the format custom date values are stored in
+const DateLayout = "2006-01-02"
+
+// Field is a custom field definition of a project, task values are stored under its key
+type Field struct {
+	ID        uint      `gorm:"primarykey"`
+	ProjectID uint      `gorm:"not null;uniqueIndex:idx_project_field_key"`
+	Key       string    `gorm:"not null;uniqueIndex:idx_project_field_key"`
+	Name      string    `gorm:"not null"`
+	Type      FieldType `gorm:"not null"`
+	Options   []string  `gorm:"type:jsonb;serializer:json"` // allowed values of select fields
+	Required  bool      `gorm:"not null;default:false"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (Field) TableName() string {
+	return "project_fields"
+}
+
+// Normalize checks a JSON decoded value against the field type and returns the value to store
+// User fields return the username, resolving it to an id is left to the caller
+func (f Field) Normalize(value interface{}) (interface{}, error) {
+	switch f.Type {
+	case FieldTypeText, FieldTypeUser:
+		s, ok := value.(string)
+		if !ok || s == "" {
+			return nil, fmt.Errorf("%s must be a non empty string", f.Key)
+		}
+		return s, nil
+
+	case FieldTypeNumber:
+		n, ok := value.(float64)
+		if !ok {
+			return nil, fmt.Errorf("%s must be a number", f.Key)
+		}
+		return n, nil
+
+	case FieldTypeDate:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s must be a date", f.Key)
+		}
+		if t, err := time.Parse(DateLayout, s); err == nil {
+			return t.Format(DateLayout), nil
+		}
+		if t, err := time.Parse(time.RFC3339, s); err == nil {
+			return t.Format(DateLayout), nil
+		}
+		return nil, fmt.Errorf("%s must be a date", f.Key)
+
+	case FieldTypeSelect:
+		s, ok := value.(string)
+		if !ok || !f.hasOption(s) {
+			return nil, fmt.Errorf("%s must be one of the field options", f.Key)
+		}
+		return s, nil
+
+	case FieldTypeMultiSelect:
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s must be a list of field options", f.Key)
+		}
+		result := make([]string, 0, len(items))
+		seen := make(map[string]bool)
+		for _, item := range items {
+			s, ok := item.(string)
+			if !ok || !f.hasOption(s) {
+				return nil, fmt.Errorf("%s must be a list of field options", f.Key)
+			}
+			if !seen[s] {
+				result = append(result, s)
+				seen[s] = true
+			}
+		}
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("%s has unknown type %s", f.Key, f.Type)
+}
+
+func (f Field) hasOption(value string) bool {
+	for _, option := range f.Options {
+		if option == value {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidFieldType reports whether t is a supported custom field type
+func IsValidFieldType(t FieldType) bool {
+	switch t {
+	case FieldTypeText, FieldTypeNumber, FieldTypeDate, FieldTypeSelect, FieldTypeMultiSelect, FieldTypeUser:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldNormalize(t *testing.T) {
+	options := []string{"dev", "staging", "prod"}
+
+	tests := []struct {
+		name    string
+		field   Field
+		value   interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{"text", Field{Key: "customer", Type: FieldTypeText}, "ACME", "ACME", false},
+		{"empty text", Field{Key: "customer", Type: FieldTypeText}, "", nil, true},
+		{"number", Field{Key: "points", Type: FieldTypeNumber}, float64(5), float64(5), false},
+		{"number as string", Field{Key: "points", Type: FieldTypeNumber}, "5", nil, true},
+		{"date", Field{Key: "release", Type: FieldTypeDate}, "2025-03-01", "2025-03-01", false},
+		{"date time", Field{Key: "release", Type: FieldTypeDate}, "2025-03-01T10:00:00Z", "2025-03-01", false},
+		{"invalid date", Field{Key: "release", Type: FieldTypeDate}, "March", nil, true},
+		{"select", Field{Key: "env", Type: FieldTypeSelect, Options: options}, "prod", "prod", false},
+		{"select unknown option", Field{Key: "env", Type: FieldTypeSelect, Options: options}, "qa", nil, true},
+		{"multi select", Field{Key: "envs", Type: FieldTypeMultiSelect, Options: options}, []interface{}{"dev", "prod", "dev"}, []string{"dev", "prod"}, false},
+		{"multi select unknown option", Field{Key: "envs", Type: FieldTypeMultiSelect, Options: options}, []interface{}{"qa"}, nil, true},
+		{"user", Field{Key: "reviewer", Type: FieldTypeUser}, "admin", "admin", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.field.Normalize(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package entity
+
+import "gorm.io/gorm"
+
+// Project groups tasks and owns their custom field definitions
+type Project struct {
+	gorm.Model
+	Name        string `gorm:"not null;uniqueIndex"`
+	Description string `gorm:"not null;default:''"`
+}
+
+func (p *Project) TableName() string {
+	return "projects"
+}
@@ -0,0 +1,61 @@
+package mocks
+
+import (
+	"task_mng/domain/project/entity"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockProjectRepository struct {
+	mock.Mock
+}
+
+func (m *MockProjectRepository) Create(e *entity.Project) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockProjectRepository) Update(e entity.Project) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockProjectRepository) FindByID(id uint) (entity.Project, error) {
+	args := m.Called(id)
+	return args.Get(0).(entity.Project), args.Error(1)
+}
+
+func (m *MockProjectRepository) FindByName(name string) (entity.Project, error) {
+	args := m.Called(name)
+	return args.Get(0).(entity.Project), args.Error(1)
+}
+
+func (m *MockProjectRepository) FindAll(page, limit int) ([]entity.Project, int64, error) {
+	args := m.Called(page, limit)
+	return args.Get(0).([]entity.Project), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockProjectRepository) CreateField(e *entity.Field) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockProjectRepository) UpdateField(e entity.Field) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockProjectRepository) DeleteField(e entity.Field) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockProjectRepository) FindField(projectID, id uint) (entity.Field, error) {
+	args := m.Called(projectID, id)
+	return args.Get(0).(entity.Field), args.Error(1)
+}
+
+func (m *MockProjectRepository) FindFields(projectID uint) ([]entity.Field, error) {
+	args := m.Called(projectID)
+	return args.Get(0).([]entity.Field), args.Error(1)
+}
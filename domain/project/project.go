@@ -0,0 +1,75 @@
+package project
+
+import (
+	"task_mng/domain/project/entity"
+	"task_mng/pkg/postgres"
+)
+
+type repository struct {
+	db *postgres.Database
+}
+
+func New(db *postgres.Database) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Create(e *entity.Project) error {
+	return r.db.Create(e).Error
+}
+
+func (r *repository) Update(e entity.Project) error {
+	return r.db.Save(&e).Error
+}
+
+func (r *repository) FindByID(id uint) (entity.Project, error) {
+	var project entity.Project
+	err := r.db.Where("id = ?", id).First(&project).Error
+	return project, err
+}
+
+func (r *repository) FindByName(name string) (entity.Project, error) {
+	var project entity.Project
+	err := r.db.Where("name = ?", name).First(&project).Error
+	return project, err
+}
+
+func (r *repository) FindAll(page, limit int) ([]entity.Project, int64, error) {
+	var projects []entity.Project
+	var count int64
+
+	offset := (page - 1) * limit
+
+	query := r.db.Model(&entity.Project{})
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return projects, count, err
+	}
+
+	err = query.Order("name ASC").Offset(offset).Limit(limit).Find(&projects).Error
+	return projects, count, err
+}
+
+func (r *repository) CreateField(e *entity.Field) error {
+	return r.db.Create(e).Error
+}
+
+func (r *repository) UpdateField(e entity.Field) error {
+	return r.db.Save(&e).Error
+}
+
+func (r *repository) DeleteField(e entity.Field) error {
+	return r.db.Delete(&e).Error
+}
+
+func (r *repository) FindField(projectID, id uint) (entity.Field, error) {
+	var field entity.Field
+	err := r.db.Where("project_id = ? AND id = ?", projectID, id).First(&field).Error
+	return field, err
+}
+
+func (r *repository) FindFields(projectID uint) ([]entity.Field, error) {
+	var fields []entity.Field
+	err := r.db.Where("project_id = ?", projectID).Order("id ASC").Find(&fields).Error
+	return fields, err
+}
@@ -0,0 +1,16 @@
+package project
+
+import "task_mng/domain/project/entity"
+
+type Repository interface {
+	Create(e *entity.Project) error
+	Update(e entity.Project) error
+	FindByID(id uint) (entity.Project, error)
+	FindByName(name string) (entity.Project, error)
+	FindAll(page, limit int) ([]entity.Project, int64, error)
+	CreateField(e *entity.Field) error
+	UpdateField(e entity.Field) error
+	DeleteField(e entity.Field) error
+	FindField(projectID, id uint) (entity.Field, error)
+	FindFields(projectID uint) ([]entity.Field, error)
+}
@@ -0,0 +1,72 @@
+package aggregate
+
+import (
+	"strings"
+	"task_mng/domain/recurrence/entity"
+	taskEntity "task_mng/domain/task/entity"
+	"task_mng/pkg/response"
+	"time"
+)
+
+type RecurrenceResponse struct {
+	ID          uint                `json:"id"`
+	Summary     string              `json:"summary"`
+	Description string              `json:"description"`
+	Assignee    uint                `json:"assignee"`
+	Priority    taskEntity.Priority `json:"priority"`
+	DueInHours  int                 `json:"due_in_hours"`
+	Frequency   entity.Frequency    `json:"frequency"`
+	Interval    int                 `json:"interval"`
+	ByWeekday   []string            `json:"by_weekday,omitempty"`
+	StartAt     time.Time           `json:"start_at"`
+	Until       *time.Time          `json:"until,omitempty"`
+	Count       int                 `json:"count"`
+	Occurrences int                 `json:"occurrences"`
+	Mode        entity.Mode         `json:"mode"`
+	NextRunAt   *time.Time          `json:"next_run_at"`
+	LastTaskID  *uint               `json:"last_task_id"`
+	CreatedAt   time.Time           `json:"created_at"`
+}
+
+func NewRecurrenceResponse(recurrence *entity.Recurrence) *RecurrenceResponse {
+	var byWeekday []string
+	if recurrence.ByWeekday != "" {
+		byWeekday = strings.Split(recurrence.ByWeekday, ",")
+	}
+
+	return &RecurrenceResponse{
+		ID:          recurrence.ID,
+		Summary:     recurrence.Summary,
+		Description: recurrence.Description,
+		Assignee:    recurrence.Assignee,
+		Priority:    recurrence.Priority,
+		DueInHours:  recurrence.DueInHours,
+		Frequency:   recurrence.Frequency,
+		Interval:    recurrence.Interval,
+		ByWeekday:   byWeekday,
+		StartAt:     recurrence.StartAt,
+		Until:       recurrence.Until,
+		Count:       recurrence.Count,
+		Occurrences: recurrence.Occurrences,
+		Mode:        recurrence.Mode,
+		NextRunAt:   recurrence.NextRunAt,
+		LastTaskID:  recurrence.LastTaskID,
+		CreatedAt:   recurrence.CreatedAt,
+	}
+}
+
+type RecurrenceListResponse struct {
+	Recurrences []*RecurrenceResponse `json:"recurrences"`
+	Meta        *response.Meta        `json:"-"`
+}
+
+func NewRecurrenceListResponse(recurrences []entity.Recurrence, page, limit int, count int64, sort string) *RecurrenceListResponse {
+	recurrenceResponses := make([]*RecurrenceResponse, len(recurrences))
+	for i, recurrence := range recurrences {
+		recurrenceResponses[i] = NewRecurrenceResponse(&recurrence)
+	}
+	return &RecurrenceListResponse{
+		Recurrences: recurrenceResponses,
+		Meta:        response.NewMeta(page, limit, int(count), sort),
+	}
+}
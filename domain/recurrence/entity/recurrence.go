@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"task_mng/domain/task/entity"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "daily"
+	FrequencyWeekly  Frequency = "weekly"
+	FrequencyMonthly Frequency = "monthly"
+)
+
+func (f Frequency) String() string {
+	return string(f)
+}
+
+type Mode string
+
+const (
+	// ModeSchedule creates a new occurrence every time the rule fires
+	ModeSchedule Mode = "schedule"
+	// ModeCompletion creates a new occurrence only once the previous one is done
+	ModeCompletion Mode = "completion"
+)
+
+func (m Mode) String() string {
+	return string(m)
+}
+
+// Recurrence is a task template with a recurrence rule (RRULE subset)
+type Recurrence struct {
+	gorm.Model
+	Summary     string          `gorm:"not null"`
+	Description string          `gorm:"not null"`
+	Assignee    uint            `gorm:"not null"` // user id for foreign key
+	Priority    entity.Priority `gorm:"not null"`
+	DueInHours  int             `gorm:"not null;default:0"` // due date of occurrences relative to the occurrence time, 0 means no due date
+	Frequency   Frequency       `gorm:"not null"`
+	Interval    int             `gorm:"not null;default:1"`
+	ByWeekday   string          // comma separated RRULE weekdays, e.g. MO,WE,FR
+	StartAt     time.Time       `gorm:"not null"`
+	Until       *time.Time
+	Count       int        `gorm:"not null;default:0"` // maximum number of occurrences, 0 means unlimited
+	Occurrences int        `gorm:"not null;default:0"`
+	Mode        Mode       `gorm:"not null"`
+	NextRunAt   *time.Time `gorm:"index"` // nil when the rule is exhausted
+	LastTaskID  *uint
+}
+
+func (Recurrence) TableName() string {
+	return "recurrences"
+}
@@ -0,0 +1,151 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// ParseWeekdays parses RRULE weekday codes (MO, TU, ...) into a normalized comma separated list
+func ParseWeekdays(days []string) (string, error) {
+	seen := make(map[string]bool)
+	codes := make([]string, 0, len(days))
+	for _, day := range days {
+		code := strings.ToUpper(strings.TrimSpace(day))
+		if _, ok := weekdays[code]; !ok {
+			return "", fmt.Errorf("invalid weekday: %s", day)
+		}
+		if !seen[code] {
+			seen[code] = true
+			codes = append(codes, code)
+		}
+	}
+	return strings.Join(codes, ","), nil
+}
+
+// Weekdays returns the rule weekdays ordered from Monday to Sunday
+func (r *Recurrence) Weekdays() []time.Weekday {
+	if r.ByWeekday == "" {
+		return nil
+	}
+
+	days := make([]time.Weekday, 0)
+	for _, code := range strings.Split(r.ByWeekday, ",") {
+		if day, ok := weekdays[code]; ok {
+			days = append(days, day)
+		}
+	}
+
+	sort.Slice(days, func(i, j int) bool {
+		return mondayOffset(days[i]) < mondayOffset(days[j])
+	})
+
+	return days
+}
+
+// Next returns the first occurrence strictly after the given time
+// Returns false when the rule is exhausted by its count or end date
+func (r *Recurrence) Next(after time.Time) (time.Time, bool) {
+	if r.Count > 0 && r.Occurrences >= r.Count {
+		return time.Time{}, false
+	}
+
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	var next time.Time
+	switch r.Frequency {
+	case FrequencyDaily:
+		next = r.nextDaily(after, interval)
+	case FrequencyWeekly:
+		next = r.nextWeekly(after, interval)
+	case FrequencyMonthly:
+		next = r.nextMonthly(after, interval)
+	default:
+		return time.Time{}, false
+	}
+
+	if r.Until != nil && next.After(*r.Until) {
+		return time.Time{}, false
+	}
+
+	return next, true
+}
+
+func (r *Recurrence) nextDaily(after time.Time, interval int) time.Time {
+	if r.StartAt.After(after) {
+		return r.StartAt
+	}
+
+	// Jump close to the answer instead of walking every period
+	days := int(after.Sub(r.StartAt).Hours()/24) / interval * interval
+	next := r.StartAt.AddDate(0, 0, days)
+	for !next.After(after) {
+		next = next.AddDate(0, 0, interval)
+	}
+
+	return next
+}
+
+func (r *Recurrence) nextWeekly(after time.Time, interval int) time.Time {
+	days := r.Weekdays()
+	if len(days) == 0 {
+		days = []time.Weekday{r.StartAt.Weekday()}
+	}
+
+	// Monday of the week the rule starts in, keeping the start time of day
+	weekStart := r.StartAt.AddDate(0, 0, -mondayOffset(r.StartAt.Weekday()))
+
+	weeks := 0
+	if after.After(weekStart) {
+		weeks = int(after.Sub(weekStart).Hours()/(24*7)) / interval * interval
+	}
+
+	for {
+		week := weekStart.AddDate(0, 0, weeks*7)
+		for _, day := range days {
+			candidate := week.AddDate(0, 0, mondayOffset(day))
+			if !candidate.Before(r.StartAt) && candidate.After(after) {
+				return candidate
+			}
+		}
+		weeks += interval
+	}
+}
+
+func (r *Recurrence) nextMonthly(after time.Time, interval int) time.Time {
+	start := r.StartAt
+
+	months := 0
+	if after.After(start) {
+		months = ((after.Year()-start.Year())*12 + int(after.Month()-start.Month())) / interval * interval
+	}
+
+	for {
+		candidate := time.Date(start.Year(), start.Month()+time.Month(months), start.Day(),
+			start.Hour(), start.Minute(), start.Second(), 0, start.Location())
+		// Months without the start day (e.g. the 31st) are skipped like RRULE does
+		if candidate.Day() == start.Day() && !candidate.Before(start) && candidate.After(after) {
+			return candidate
+		}
+		months += interval
+	}
+}
+
+// mondayOffset returns the number of days since Monday
+func mondayOffset(day time.Weekday) int {
+	return (int(day) + 6) % 7
+}
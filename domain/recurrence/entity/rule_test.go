@@ -0,0 +1,99 @@
+package entity_test
+
+import (
+	"task_mng/domain/recurrence/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 9, 0, 0, 0, time.UTC)
+}
+
+func TestNext(t *testing.T) {
+	until := date(2025, time.January, 5)
+
+	tests := []struct {
+		name       string
+		recurrence entity.Recurrence
+		after      time.Time
+		want       time.Time
+		ok         bool
+	}{
+		{
+			name:       "first daily occurrence is the start",
+			recurrence: entity.Recurrence{Frequency: entity.FrequencyDaily, Interval: 1, StartAt: date(2025, time.January, 1)},
+			after:      date(2024, time.December, 1),
+			want:       date(2025, time.January, 1),
+			ok:         true,
+		},
+		{
+			name:       "daily with interval",
+			recurrence: entity.Recurrence{Frequency: entity.FrequencyDaily, Interval: 3, StartAt: date(2025, time.January, 1)},
+			after:      date(2025, time.January, 5),
+			want:       date(2025, time.January, 7),
+			ok:         true,
+		},
+		{
+			name:       "weekly defaults to the start weekday",
+			recurrence: entity.Recurrence{Frequency: entity.FrequencyWeekly, Interval: 1, StartAt: date(2025, time.January, 1)}, // Wednesday
+			after:      date(2025, time.January, 1),
+			want:       date(2025, time.January, 8),
+			ok:         true,
+		},
+		{
+			name:       "weekly by weekday",
+			recurrence: entity.Recurrence{Frequency: entity.FrequencyWeekly, Interval: 1, ByWeekday: "MO,FR", StartAt: date(2025, time.January, 1)},
+			after:      date(2025, time.January, 3), // Friday
+			want:       date(2025, time.January, 6), // Monday
+			ok:         true,
+		},
+		{
+			name:       "biweekly by weekday",
+			recurrence: entity.Recurrence{Frequency: entity.FrequencyWeekly, Interval: 2, ByWeekday: "MO", StartAt: date(2025, time.January, 6)},
+			after:      date(2025, time.January, 6),
+			want:       date(2025, time.January, 20),
+			ok:         true,
+		},
+		{
+			name:       "monthly skips months without the day",
+			recurrence: entity.Recurrence{Frequency: entity.FrequencyMonthly, Interval: 1, StartAt: date(2025, time.January, 31)},
+			after:      date(2025, time.January, 31),
+			want:       date(2025, time.March, 31),
+			ok:         true,
+		},
+		{
+			name:       "until ends the rule",
+			recurrence: entity.Recurrence{Frequency: entity.FrequencyDaily, Interval: 7, StartAt: date(2025, time.January, 1), Until: &until},
+			after:      date(2025, time.January, 1),
+			ok:         false,
+		},
+		{
+			name:       "count ends the rule",
+			recurrence: entity.Recurrence{Frequency: entity.FrequencyDaily, Interval: 1, StartAt: date(2025, time.January, 1), Count: 2, Occurrences: 2},
+			after:      date(2025, time.January, 2),
+			ok:         false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.recurrence.Next(tt.after)
+			assert.Equal(t, tt.ok, ok)
+			if tt.ok {
+				assert.True(t, tt.want.Equal(got), "expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseWeekdays(t *testing.T) {
+	days, err := entity.ParseWeekdays([]string{"mo", "FR", "MO"})
+	assert.NoError(t, err)
+	assert.Equal(t, "MO,FR", days)
+
+	_, err = entity.ParseWeekdays([]string{"XX"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,42 @@
+package mocks
+
+import (
+	"task_mng/domain/recurrence/entity"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRecurrenceRepository struct {
+	mock.Mock
+}
+
+func (m *MockRecurrenceRepository) Create(e *entity.Recurrence) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockRecurrenceRepository) Update(e entity.Recurrence) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockRecurrenceRepository) FindByID(id uint) (entity.Recurrence, error) {
+	args := m.Called(id)
+	return args.Get(0).(entity.Recurrence), args.Error(1)
+}
+
+func (m *MockRecurrenceRepository) FindAll(page, limit int) ([]entity.Recurrence, int64, error) {
+	args := m.Called(page, limit)
+	return args.Get(0).([]entity.Recurrence), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockRecurrenceRepository) FindDue(now time.Time) ([]entity.Recurrence, error) {
+	args := m.Called(now)
+	return args.Get(0).([]entity.Recurrence), args.Error(1)
+}
+
+func (m *MockRecurrenceRepository) Delete(e entity.Recurrence) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
@@ -0,0 +1,57 @@
+package recurrence
+
+import (
+	"task_mng/domain/recurrence/entity"
+	"task_mng/pkg/postgres"
+	"time"
+)
+
+type repository struct {
+	db *postgres.Database
+}
+
+func New(db *postgres.Database) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Create(e *entity.Recurrence) error {
+	return r.db.Create(e).Error
+}
+
+func (r *repository) Update(e entity.Recurrence) error {
+	return r.db.Save(&e).Error
+}
+
+func (r *repository) FindByID(id uint) (entity.Recurrence, error) {
+	var recurrence entity.Recurrence
+	err := r.db.Where("id = ?", id).First(&recurrence).Error
+	return recurrence, err
+}
+
+func (r *repository) FindAll(page, limit int) ([]entity.Recurrence, int64, error) {
+	var recurrences []entity.Recurrence
+	var count int64
+
+	offset := (page - 1) * limit
+
+	err := r.db.Model(&entity.Recurrence{}).Count(&count).Error
+	if err != nil {
+		return recurrences, count, err
+	}
+
+	err = r.db.Order("id ASC").Offset(offset).Limit(limit).Find(&recurrences).Error
+	return recurrences, count, err
+}
+
+// FindDue returns the rules whose next occurrence is due
+func (r *repository) FindDue(now time.Time) ([]entity.Recurrence, error) {
+	var recurrences []entity.Recurrence
+	err := r.db.Where("next_run_at IS NOT NULL AND next_run_at <= ?", now).
+		Order("next_run_at ASC").
+		Find(&recurrences).Error
+	return recurrences, err
+}
+
+func (r *repository) Delete(e entity.Recurrence) error {
+	return r.db.Delete(&e).Error
+}
@@ -0,0 +1,15 @@
+package recurrence
+
+import (
+	"task_mng/domain/recurrence/entity"
+	"time"
+)
+
+type Repository interface {
+	Create(e *entity.Recurrence) error
+	Update(e entity.Recurrence) error
+	FindByID(id uint) (entity.Recurrence, error)
+	FindAll(page, limit int) ([]entity.Recurrence, int64, error)
+	FindDue(now time.Time) ([]entity.Recurrence, error)
+	Delete(e entity.Recurrence) error
+}
@@ -0,0 +1,71 @@
+package aggregate
+
+import (
+	"task_mng/domain/sprint/entity"
+	"task_mng/pkg/response"
+	"time"
+)
+
+type SprintResponse struct {
+	ID          uint         `json:"id"`
+	Name        string       `json:"name"`
+	Goal        string       `json:"goal"`
+	StartDate   time.Time    `json:"start_date"`
+	EndDate     time.Time    `json:"end_date"`
+	State       entity.State `json:"state"`
+	StartedAt   *time.Time   `json:"started_at,omitempty"`
+	CompletedAt *time.Time   `json:"completed_at,omitempty"`
+	CreatedAt   time.Time    `json:"created_at"`
+}
+
+func NewSprintResponse(sprint *entity.Sprint) *SprintResponse {
+	return &SprintResponse{
+		ID:          sprint.ID,
+		Name:        sprint.Name,
+		Goal:        sprint.Goal,
+		StartDate:   sprint.StartDate,
+		EndDate:     sprint.EndDate,
+		State:       sprint.State,
+		StartedAt:   sprint.StartedAt,
+		CompletedAt: sprint.CompletedAt,
+		CreatedAt:   sprint.CreatedAt,
+	}
+}
+
+type SprintListResponse struct {
+	Sprints []*SprintResponse `json:"sprints"`
+	Meta    *response.Meta    `json:"-"`
+}
+
+func NewSprintListResponse(sprints []entity.Sprint, page, limit int, count int64, sort string) *SprintListResponse {
+	sprintResponses := make([]*SprintResponse, len(sprints))
+	for i, sprint := range sprints {
+		sprintResponses[i] = NewSprintResponse(&sprint)
+	}
+	return &SprintListResponse{
+		Sprints: sprintResponses,
+		Meta:    response.NewMeta(page, limit, int(count), sort),
+	}
+}
+
+// CompleteResponse reports where the unfinished tasks of a completed sprint went
+type CompleteResponse struct {
+	Sprint       *SprintResponse `json:"sprint"`
+	CarriedOver  []uint          `json:"carried_over"`
+	NextSprintID *uint           `json:"next_sprint_id"` // nil when moved to the backlog
+}
+
+// BurndownPoint is the remaining work at the end of a sprint day
+type BurndownPoint struct {
+	Date              string  `json:"date"`
+	RemainingTasks    int     `json:"remaining_tasks"`
+	RemainingEstimate int     `json:"remaining_estimate"` // minutes
+	IdealTasks        float64 `json:"ideal_tasks"`
+}
+
+type BurndownResponse struct {
+	SprintID      uint            `json:"sprint_id"`
+	TotalTasks    int             `json:"total_tasks"`
+	TotalEstimate int             `json:"total_estimate"` // minutes
+	Points        []BurndownPoint `json:"points"`
+}
@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type State string
+
+const (
+	StatePlanned   State = "planned"
+	StateActive    State = "active"
+	StateCompleted State = "completed"
+)
+
+type Sprint struct {
+	gorm.Model
+	Name        string    `gorm:"not null"`
+	Goal        string    `gorm:"not null;default:''"`
+	StartDate   time.Time `gorm:"not null"`
+	EndDate     time.Time `gorm:"not null"`
+	State       State     `gorm:"not null;index"`
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+}
+
+func (s *Sprint) TableName() string {
+	return "sprints"
+}
@@ -0,0 +1,36 @@
+package mocks
+
+import (
+	"task_mng/domain/sprint/entity"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSprintRepository struct {
+	mock.Mock
+}
+
+func (m *MockSprintRepository) Create(e *entity.Sprint) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockSprintRepository) Update(e entity.Sprint) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockSprintRepository) FindByID(id uint) (entity.Sprint, error) {
+	args := m.Called(id)
+	return args.Get(0).(entity.Sprint), args.Error(1)
+}
+
+func (m *MockSprintRepository) FindAll(page, limit int) ([]entity.Sprint, int64, error) {
+	args := m.Called(page, limit)
+	return args.Get(0).([]entity.Sprint), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockSprintRepository) FindByState(state entity.State) ([]entity.Sprint, error) {
+	args := m.Called(state)
+	return args.Get(0).([]entity.Sprint), args.Error(1)
+}
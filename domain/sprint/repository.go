@@ -0,0 +1,11 @@
+package sprint
+
+import "task_mng/domain/sprint/entity"
+
+type Repository interface {
+	Create(e *entity.Sprint) error
+	Update(e entity.Sprint) error
+	FindByID(id uint) (entity.Sprint, error)
+	FindAll(page, limit int) ([]entity.Sprint, int64, error)
+	FindByState(state entity.State) ([]entity.Sprint, error)
+}
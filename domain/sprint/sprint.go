@@ -0,0 +1,51 @@
+package sprint
+
+import (
+	"task_mng/domain/sprint/entity"
+	"task_mng/pkg/postgres"
+)
+
+type repository struct {
+	db *postgres.Database
+}
+
+func New(db *postgres.Database) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Create(e *entity.Sprint) error {
+	return r.db.Create(e).Error
+}
+
+func (r *repository) Update(e entity.Sprint) error {
+	return r.db.Save(&e).Error
+}
+
+func (r *repository) FindByID(id uint) (entity.Sprint, error) {
+	var sprint entity.Sprint
+	err := r.db.Where("id = ?", id).First(&sprint).Error
+	return sprint, err
+}
+
+func (r *repository) FindAll(page, limit int) ([]entity.Sprint, int64, error) {
+	var sprints []entity.Sprint
+	var count int64
+
+	offset := (page - 1) * limit
+
+	query := r.db.Model(&entity.Sprint{})
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return sprints, count, err
+	}
+
+	err = query.Order("start_date DESC").Offset(offset).Limit(limit).Find(&sprints).Error
+	return sprints, count, err
+}
+
+func (r *repository) FindByState(state entity.State) ([]entity.Sprint, error) {
+	var sprints []entity.Sprint
+	err := r.db.Where("state = ?", state).Order("start_date ASC").Find(&sprints).Error
+	return sprints, err
+}
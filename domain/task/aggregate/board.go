@@ -0,0 +1,63 @@
+package aggregate
+
+import "task_mng/domain/task/entity"
+
+// BoardStatuses is the left to right order of the board columns
+var BoardStatuses = []entity.Status{entity.StatusTodo, entity.StatusInProgress, entity.StatusDone}
+
+type ColumnResponse struct {
+	Status   entity.Status `json:"status"`
+	WipLimit int           `json:"wip_limit"` // 0 means unlimited
+}
+
+type BoardColumn struct {
+	Status   entity.Status   `json:"status"`
+	WipLimit int             `json:"wip_limit"`
+	Count    int             `json:"count"`
+	Tasks    []*TaskResponse `json:"tasks"`
+}
+
+type BoardResponse struct {
+	Columns []BoardColumn `json:"columns"`
+}
+
+// NewColumnListResponse returns the settings of every board column, unconfigured columns have no limit
+func NewColumnListResponse(columns []entity.Column) []ColumnResponse {
+	limits := make(map[entity.Status]int)
+	for _, column := range columns {
+		limits[column.Status] = column.WipLimit
+	}
+
+	result := make([]ColumnResponse, len(BoardStatuses))
+	for i, status := range BoardStatuses {
+		result[i] = ColumnResponse{Status: status, WipLimit: limits[status]}
+	}
+	return result
+}
+
+// NewBoardResponse groups tasks ordered by rank into their status columns
+func NewBoardResponse(tasks []entity.Task, columns []entity.Column, usernames map[uint]string) *BoardResponse {
+	settings := NewColumnListResponse(columns)
+
+	index := make(map[entity.Status]int)
+	board := &BoardResponse{Columns: make([]BoardColumn, len(settings))}
+	for i, column := range settings {
+		index[column.Status] = i
+		board.Columns[i] = BoardColumn{
+			Status:   column.Status,
+			WipLimit: column.WipLimit,
+			Tasks:    make([]*TaskResponse, 0),
+		}
+	}
+
+	for i := range tasks {
+		j, ok := index[tasks[i].Status]
+		if !ok {
+			continue
+		}
+		board.Columns[j].Tasks = append(board.Columns[j].Tasks, NewTaskResponse(&tasks[i], usernames))
+		board.Columns[j].Count++
+	}
+
+	return board
+}
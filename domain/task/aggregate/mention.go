@@ -0,0 +1,41 @@
+package aggregate
+
+import (
+	"task_mng/domain/task/entity"
+	"task_mng/pkg/response"
+	"time"
+)
+
+// MentionTask represents the task a user was mentioned in
+type MentionTask struct {
+	ID      uint   `json:"id"`
+	Summary string `json:"summary"`
+}
+
+type MentionResponse struct {
+	ID          uint        `json:"id"`
+	Task        MentionTask `json:"task"`
+	MentionedBy *UserInfo   `json:"mentioned_by"`
+	CreatedAt   time.Time   `json:"created_at"`
+}
+
+type MentionListResponse struct {
+	Mentions []MentionResponse `json:"mentions"`
+	Meta     *response.Meta    `json:"-"`
+}
+
+func NewMentionListResponse(mentions []entity.Mention, summaries map[uint]string, usernames map[uint]string, page, limit int, count int64) *MentionListResponse {
+	result := make([]MentionResponse, len(mentions))
+	for i, m := range mentions {
+		result[i] = MentionResponse{
+			ID:          m.ID,
+			Task:        MentionTask{ID: m.TaskID, Summary: summaries[m.TaskID]},
+			MentionedBy: newUserInfo(m.MentionedBy, usernames),
+			CreatedAt:   m.CreatedAt,
+		}
+	}
+	return &MentionListResponse{
+		Mentions: result,
+		Meta:     response.NewMeta(page, limit, int(count), "-created_at"),
+	}
+}
@@ -12,30 +12,127 @@ type AssigneeInfo struct {
 	Username string `json:"username"`
 }
 
+// UserInfo represents a user referenced by a task
+type UserInfo struct {
+	ID       uint   `json:"id"`
+	Username string `json:"username"`
+}
+
+func newUserInfo(id *uint, usernames map[uint]string) *UserInfo {
+	if id == nil {
+		return nil
+	}
+	return &UserInfo{ID: *id, Username: usernames[*id]}
+}
+
+// UserIDs returns the distinct users referenced by the tasks, used to look up their usernames
+func UserIDs(tasks []entity.Task) []uint {
+	ids := make([]uint, 0)
+	seen := make(map[uint]bool)
+	add := func(id *uint) {
+		if id != nil && !seen[*id] {
+			ids = append(ids, *id)
+			seen[*id] = true
+		}
+	}
+
+	for i := range tasks {
+		add(&tasks[i].Assignee)
+		add(tasks[i].Reporter)
+		add(tasks[i].CreatedBy)
+		add(tasks[i].UpdatedBy)
+	}
+	return ids
+}
+
+// ChecklistProgress represents the completion of the checklist of a task
+type ChecklistProgress struct {
+	Total int     `json:"total"`
+	Done  int     `json:"done"`
+	Ratio float64 `json:"ratio"`
+}
+
+func NewChecklistProgress(total, done int) ChecklistProgress {
+	ratio := 0.0
+	if total > 0 {
+		ratio = float64(done) / float64(total)
+	}
+	return ChecklistProgress{Total: total, Done: done, Ratio: ratio}
+}
+
+// TimeTracking represents the estimates and logged work of a task in minutes
+type TimeTracking struct {
+	OriginalEstimate  int `json:"original_estimate"`
+	RemainingEstimate int `json:"remaining_estimate"`
+	TimeSpent         int `json:"time_spent"`
+}
+
 type TaskResponse struct {
-	ID          uint            `json:"id"`
-	Summary     string          `json:"summary"`
-	Description string          `json:"description"`
-	Assignee    AssigneeInfo    `json:"assignee"`
-	Status      entity.Status   `json:"status"`
-	Priority    entity.Priority `json:"priority"`
-	DueDate     time.Time       `json:"due_date"`
-	CreatedAt   time.Time       `json:"created_at"`
+	ID           uint                   `json:"id"`
+	Summary      string                 `json:"summary"`
+	Description  string                 `json:"description"`
+	Assignee     AssigneeInfo           `json:"assignee"`
+	Reporter     *UserInfo              `json:"reporter"`
+	CreatedBy    *UserInfo              `json:"created_by"`
+	UpdatedBy    *UserInfo              `json:"updated_by"`
+	Status       entity.Status          `json:"status"`
+	Priority     entity.Priority        `json:"priority"`
+	DueDate      time.Time              `json:"due_date"`
+	Labels       []string               `json:"labels"`
+	RecurrenceID *uint                  `json:"recurrence_id,omitempty"`
+	SprintID     *uint                  `json:"sprint_id,omitempty"`
+	ProjectID    *uint                  `json:"project_id,omitempty"`
+	CustomFields map[string]interface{} `json:"custom_fields"`
+	Rank         string                 `json:"rank"`
+	CompletedAt  *time.Time             `json:"completed_at,omitempty"`
+	ArchivedAt   *time.Time             `json:"archived_at,omitempty"`
+	Checklist    ChecklistProgress      `json:"checklist"`
+	TimeTracking TimeTracking           `json:"time_tracking"`
+	CreatedAt    time.Time              `json:"created_at"`
+	UpdatedAt    time.Time              `json:"updated_at"`
+	Version      uint                   `json:"version"`              // also sent as the ETag of the task
+	DeletedAt    *time.Time             `json:"deleted_at,omitempty"` // only set for tasks in the trash
 }
 
-func NewTaskResponse(task *entity.Task, assigneeUsername string) *TaskResponse {
+// NewTaskResponse builds the response of a task, usernames maps the ids returned by UserIDs to usernames
+func NewTaskResponse(task *entity.Task, usernames map[uint]string) *TaskResponse {
+	var deletedAt *time.Time
+	if task.DeletedAt.Valid {
+		deletedAt = &task.DeletedAt.Time
+	}
+
 	return &TaskResponse{
 		ID:          task.ID,
 		Summary:     task.Summary,
 		Description: task.Description,
 		Assignee: AssigneeInfo{
 			ID:       task.Assignee,
-			Username: assigneeUsername,
+			Username: usernames[task.Assignee],
+		},
+		Reporter:     newUserInfo(task.Reporter, usernames),
+		CreatedBy:    newUserInfo(task.CreatedBy, usernames),
+		UpdatedBy:    newUserInfo(task.UpdatedBy, usernames),
+		Status:       task.Status,
+		Priority:     task.Priority,
+		DueDate:      task.DueDate,
+		Labels:       task.Labels,
+		RecurrenceID: task.RecurrenceID,
+		SprintID:     task.SprintID,
+		ProjectID:    task.ProjectID,
+		CustomFields: task.CustomFields,
+		Rank:         task.Rank,
+		CompletedAt:  task.CompletedAt,
+		ArchivedAt:   task.ArchivedAt,
+		Checklist:    NewChecklistProgress(task.ChecklistTotal, task.ChecklistDone),
+		TimeTracking: TimeTracking{
+			OriginalEstimate:  task.OriginalEstimate,
+			RemainingEstimate: task.RemainingEstimate,
+			TimeSpent:         task.TimeSpent,
 		},
-		Status:    task.Status,
-		Priority:  task.Priority,
-		DueDate:   task.DueDate,
 		CreatedAt: task.CreatedAt,
+		UpdatedAt: task.UpdatedAt,
+		Version:   task.Version,
+		DeletedAt: deletedAt,
 	}
 }
 
@@ -44,11 +141,10 @@ type TaskListResponse struct {
 	Meta  *response.Meta  `json:"-"`
 }
 
-func NewTaskListResponse(tasks []entity.Task, assigneeUsernames map[uint]string, page, limit int, count int64, sort string) *TaskListResponse {
+func NewTaskListResponse(tasks []entity.Task, usernames map[uint]string, page, limit int, count int64, sort string) *TaskListResponse {
 	taskResponses := make([]*TaskResponse, len(tasks))
 	for i, task := range tasks {
-		username := assigneeUsernames[task.Assignee]
-		taskResponses[i] = NewTaskResponse(&task, username)
+		taskResponses[i] = NewTaskResponse(&task, usernames)
 	}
 	return &TaskListResponse{
 		Tasks: taskResponses,
@@ -0,0 +1,24 @@
+package aggregate
+
+import (
+	"task_mng/domain/task/entity"
+	"time"
+)
+
+type WatcherResponse struct {
+	UserID        uint      `json:"user_id"`
+	Username      string    `json:"username"`
+	WatchingSince time.Time `json:"watching_since"`
+}
+
+func NewWatcherListResponse(watchers []entity.Watcher, usernames map[uint]string) []WatcherResponse {
+	result := make([]WatcherResponse, len(watchers))
+	for i, w := range watchers {
+		result[i] = WatcherResponse{
+			UserID:        w.UserID,
+			Username:      usernames[w.UserID],
+			WatchingSince: w.CreatedAt,
+		}
+	}
+	return result
+}
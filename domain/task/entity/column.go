@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+// Column holds the board settings of a status column
+type Column struct {
+	Status    Status `gorm:"primarykey"`
+	WipLimit  int    `gorm:"not null;default:0"` // 0 means unlimited
+	UpdatedAt time.Time
+}
+
+func (Column) TableName() string {
+	return "board_columns"
+}
@@ -0,0 +1,35 @@
+package entity
+
+import "time"
+
+// History fields
+const (
+	HistoryFieldStatus            = "status"
+	HistoryFieldSprint            = "sprint_id"
+	HistoryFieldSummary           = "summary"
+	HistoryFieldDescription       = "description"
+	HistoryFieldAssignee          = "assignee"
+	HistoryFieldReporter          = "reporter"
+	HistoryFieldPriority          = "priority"
+	HistoryFieldDueDate           = "due_date"
+	HistoryFieldLabels            = "labels"
+	HistoryFieldOriginalEstimate  = "original_estimate"
+	HistoryFieldRemainingEstimate = "remaining_estimate"
+	// Custom field changes are recorded per field as custom_fields.<key>
+	HistoryFieldCustomFieldPrefix = "custom_fields."
+)
+
+// History records a change of a single task field
+type History struct {
+	ID        uint   `gorm:"primarykey"`
+	TaskID    uint   `gorm:"not null;index"`
+	Field     string `gorm:"not null;index"`
+	OldValue  string
+	NewValue  string
+	UserID    *uint     // user that made the change, nil for system changes
+	CreatedAt time.Time `gorm:"index"`
+}
+
+func (History) TableName() string {
+	return "task_histories"
+}
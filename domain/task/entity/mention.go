@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Mention records that a user was mentioned with @username in a task
+type Mention struct {
+	ID          uint  `gorm:"primarykey"`
+	TaskID      uint  `gorm:"not null;index"`
+	UserID      uint  `gorm:"not null;index"` // mentioned user
+	MentionedBy *uint // nil for system changes
+	CreatedAt   time.Time
+}
+
+func (Mention) TableName() string {
+	return "task_mentions"
+}
+
+// mentionPattern matches @username at the start of the text or after a non username character,
+// so e-mail addresses like admin@xdr.com are not taken as mentions
+var mentionPattern = regexp.MustCompile(`(?:^|[^a-zA-Z0-9_.\-@])@([a-zA-Z0-9_.\-]+)`)
+
+// ParseMentions returns the distinct usernames mentioned in the text in order of appearance
+func ParseMentions(text string) []string {
+	usernames := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		// A mention at the end of a sentence keeps the full stop out of the username
+		username := strings.TrimRight(match[1], ".-")
+		if username == "" || seen[username] {
+			continue
+		}
+		usernames = append(usernames, username)
+		seen[username] = true
+	}
+	return usernames
+}
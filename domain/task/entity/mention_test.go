@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"none", "no mentions here", []string{}},
+		{"start of text", "@admin please check", []string{"admin"}},
+		{"dotted username", "cc @test.user and @ops_team", []string{"test.user", "ops_team"}},
+		{"end of sentence", "Assigned to @admin.", []string{"admin"}},
+		{"duplicates", "@admin, @admin!", []string{"admin"}},
+		{"email is not a mention", "mail admin@xdr.com", []string{}},
+		{"lone at sign", "meet @ noon", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseMentions(tt.text))
+		})
+	}
+}
@@ -13,3 +13,26 @@ const (
 func (p Priority) String() string {
 	return string(p)
 }
+
+// IsValid reports whether p is one of the known priorities
+func (p Priority) IsValid() bool {
+	switch p {
+	case PriorityLowest, PriorityLow, PriorityMedium, PriorityHigh, PriorityHighest:
+		return true
+	}
+	return false
+}
+
+// Escalate returns the next higher priority, highest stays highest
+func (p Priority) Escalate() Priority {
+	switch p {
+	case PriorityLowest:
+		return PriorityLow
+	case PriorityLow:
+		return PriorityMedium
+	case PriorityMedium:
+		return PriorityHigh
+	default:
+		return PriorityHighest
+	}
+}
@@ -0,0 +1,95 @@
+package entity
+
+import "fmt"
+
+// rankAlphabet holds the rank digits in ascending byte order
+const rankAlphabet = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+const rankBase = len(rankAlphabet)
+
+// RankBetween returns a rank sorting strictly between prev and next
+// An empty prev means the start of the column and an empty next its end
+// The result never ends with the lowest digit, so there is always room before it
+func RankBetween(prev, next string) (string, error) {
+	if next != "" && prev >= next {
+		return "", fmt.Errorf("invalid rank range: %q is not before %q", prev, next)
+	}
+
+	rank := make([]byte, 0, len(prev)+1)
+	bounded := next != ""
+
+	for i := 0; ; i++ {
+		lo := 0
+		if i < len(prev) {
+			lo = rankDigit(prev[i])
+		}
+
+		hi := rankBase
+		if bounded && i < len(next) {
+			hi = rankDigit(next[i])
+		}
+
+		if hi-lo > 1 {
+			return string(append(rank, rankAlphabet[(lo+hi)/2])), nil
+		}
+
+		rank = append(rank, rankAlphabet[lo])
+
+		// Once the rank is below next at this digit, the following digits are free
+		if hi-lo == 1 {
+			bounded = false
+		}
+	}
+}
+
+// RankSequence returns n evenly spread ascending ranks, used to rebalance a column
+func RankSequence(n int) []string {
+	ranks := make([]string, n)
+	if n == 0 {
+		return ranks
+	}
+
+	// Use enough digits to leave gaps between consecutive ranks
+	width := 1
+	for capacity := rankBase; capacity < 2*(n+1); capacity *= rankBase {
+		width++
+	}
+
+	total := 1
+	for i := 0; i < width; i++ {
+		total *= rankBase
+	}
+
+	step := total / (n + 1)
+	for i := range ranks {
+		value := step * (i + 1)
+		digits := make([]byte, width)
+		for j := width - 1; j >= 0; j-- {
+			digits[j] = rankAlphabet[value%rankBase]
+			value /= rankBase
+		}
+		ranks[i] = trimRank(string(digits))
+	}
+
+	return ranks
+}
+
+func rankDigit(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'a' && c <= 'z':
+		return int(c-'a') + 10
+	default:
+		return 0
+	}
+}
+
+// trimRank drops trailing lowest digits, they don't change the ordering
+func trimRank(rank string) string {
+	end := len(rank)
+	for end > 1 && rank[end-1] == rankAlphabet[0] {
+		end--
+	}
+	return rank[:end]
+}
@@ -0,0 +1,71 @@
+package entity
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankBetween(t *testing.T) {
+	tests := []struct {
+		name string
+		prev string
+		next string
+	}{
+		{name: "empty column", prev: "", next: ""},
+		{name: "start of column", prev: "", next: "i"},
+		{name: "end of column", prev: "i", next: ""},
+		{name: "wide gap", prev: "a", next: "z"},
+		{name: "adjacent digits", prev: "a", next: "b"},
+		{name: "common prefix", prev: "ab", next: "ac"},
+		{name: "prefix of next", prev: "a", next: "a1"},
+		{name: "before lowest digits", prev: "", next: "01"},
+		{name: "after highest digits", prev: "zz", next: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rank, err := RankBetween(tt.prev, tt.next)
+			require.NoError(t, err)
+			assert.Greater(t, rank, tt.prev)
+			if tt.next != "" {
+				assert.Less(t, rank, tt.next)
+			}
+			assert.NotEqual(t, byte('0'), rank[len(rank)-1])
+		})
+	}
+}
+
+func TestRankBetween_InvalidRange(t *testing.T) {
+	_, err := RankBetween("b", "a")
+	assert.Error(t, err)
+
+	_, err = RankBetween("a", "a")
+	assert.Error(t, err)
+}
+
+func TestRankBetween_RepeatedInsertsStayOrdered(t *testing.T) {
+	// Keep inserting right after the first rank, the worst case for rank growth
+	ranks := []string{"i"}
+	for i := 0; i < 200; i++ {
+		rank, err := RankBetween("", ranks[0])
+		require.NoError(t, err)
+		ranks = append([]string{rank}, ranks...)
+	}
+
+	assert.True(t, sort.StringsAreSorted(ranks))
+}
+
+func TestRankSequence(t *testing.T) {
+	ranks := RankSequence(100)
+
+	require.Len(t, ranks, 100)
+	assert.True(t, sort.StringsAreSorted(ranks))
+	for i := 1; i < len(ranks); i++ {
+		assert.NotEqual(t, ranks[i-1], ranks[i])
+		_, err := RankBetween(ranks[i-1], ranks[i])
+		assert.NoError(t, err)
+	}
+}
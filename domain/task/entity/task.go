@@ -8,12 +8,31 @@ import (
 
 type Task struct {
 	gorm.Model
-	Summary     string    `gorm:"not null"`
-	Description string    `gorm:"not null"`
-	Assignee    uint      `gorm:"not null"` // user id for foreign key
-	Status      Status    `gorm:"not null"`
-	Priority    Priority  `gorm:"not null"`
-	DueDate     time.Time `gorm:"not null"`
+	Summary        string                 `gorm:"not null"`
+	Description    string                 `gorm:"not null"`
+	Assignee       uint                   `gorm:"not null"` // user id for foreign key
+	Reporter       *uint                  `gorm:"index"`    // user that reported the task, nil for system created tasks
+	CreatedBy      *uint                  // user that created the task, nil for system created tasks
+	UpdatedBy      *uint                  // user that last changed the task, nil for system changes
+	Status         Status                 `gorm:"not null"`
+	Priority       Priority               `gorm:"not null"`
+	DueDate        time.Time              `gorm:"not null"`
+	Labels         []string               `gorm:"type:jsonb;serializer:json"`
+	RecurrenceID   *uint                  `gorm:"index"` // recurrence rule that generated the task
+	SprintID       *uint                  `gorm:"index"`
+	ProjectID      *uint                  `gorm:"index"`
+	CustomFields   map[string]interface{} `gorm:"type:jsonb;serializer:json"` // values keyed by the project field keys
+	Rank           string                 `gorm:"not null;default:'';index"`  // lexicographic order inside the status column
+	CompletedAt    *time.Time             // when the task was last moved to done, nil while it is not done
+	ArchivedAt     *time.Time             `gorm:"index"` // archived tasks are left out of listings unless asked for
+	ChecklistTotal int                    `gorm:"not null;default:0"`
+	ChecklistDone  int                    `gorm:"not null;default:0"`
+	// Time tracking in minutes
+	OriginalEstimate  int `gorm:"not null;default:0"`
+	RemainingEstimate int `gorm:"not null;default:0"`
+	TimeSpent         int `gorm:"not null;default:0"` // sum of the work logs
+	// Version is bumped by every update and guards against concurrent edits
+	Version uint `gorm:"not null;default:1"`
 }
 
 func (Task) TableName() string {
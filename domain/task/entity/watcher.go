@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+// Watcher links a user that follows the updates of a task
+type Watcher struct {
+	TaskID    uint `gorm:"primarykey"`
+	UserID    uint `gorm:"primarykey;index"`
+	CreatedAt time.Time
+}
+
+func (Watcher) TableName() string {
+	return "task_watchers"
+}
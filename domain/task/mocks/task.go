@@ -3,6 +3,7 @@ package mocks
 import (
 	"task_mng/domain/task"
 	"task_mng/domain/task/entity"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -21,6 +22,16 @@ func (m *MockTaskRepository) Update(e entity.Task) error {
 	return args.Error(0)
 }
 
+func (m *MockTaskRepository) UpdateFields(id uint, fields map[string]interface{}) error {
+	args := m.Called(id, fields)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) UpdateTimeTracking(id uint, logged int, remaining *int) error {
+	args := m.Called(id, logged, remaining)
+	return args.Error(0)
+}
+
 func (m *MockTaskRepository) FindByID(id uint) (entity.Task, error) {
 	args := m.Called(id)
 	return args.Get(0).(entity.Task), args.Error(1)
@@ -40,3 +51,123 @@ func (m *MockTaskRepository) CountByStatus() (map[entity.Status]int64, error) {
 	args := m.Called()
 	return args.Get(0).(map[entity.Status]int64), args.Error(1)
 }
+
+func (m *MockTaskRepository) FindDue(before time.Time) ([]entity.Task, error) {
+	args := m.Called(before)
+	return args.Get(0).([]entity.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) FindByIDs(ids []uint) ([]entity.Task, error) {
+	args := m.Called(ids)
+	return args.Get(0).([]entity.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) FindBySprintID(sprintID uint) ([]entity.Task, error) {
+	args := m.Called(sprintID)
+	return args.Get(0).([]entity.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) CreateHistory(entries []entity.History) error {
+	args := m.Called(entries)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) FindHistory(taskIDs []uint, field string) ([]entity.History, error) {
+	args := m.Called(taskIDs, field)
+	return args.Get(0).([]entity.History), args.Error(1)
+}
+
+func (m *MockTaskRepository) FindHistoryByOldValue(field, value string) ([]entity.History, error) {
+	args := m.Called(field, value)
+	return args.Get(0).([]entity.History), args.Error(1)
+}
+
+func (m *MockTaskRepository) FindBoard(filter *task.Filter) ([]entity.Task, error) {
+	args := m.Called(filter)
+	return args.Get(0).([]entity.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) FindByStatus(status entity.Status) ([]entity.Task, error) {
+	args := m.Called(status)
+	return args.Get(0).([]entity.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) LastRank(status entity.Status) (string, error) {
+	args := m.Called(status)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockTaskRepository) Rebalance(id uint, fields map[string]interface{}, ranks map[uint]string) error {
+	args := m.Called(id, fields, ranks)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) FindColumn(status entity.Status) (entity.Column, error) {
+	args := m.Called(status)
+	return args.Get(0).(entity.Column), args.Error(1)
+}
+
+func (m *MockTaskRepository) FindColumns() ([]entity.Column, error) {
+	args := m.Called()
+	return args.Get(0).([]entity.Column), args.Error(1)
+}
+
+func (m *MockTaskRepository) SaveColumn(e entity.Column) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) AddWatchers(taskID uint, userIDs []uint) error {
+	args := m.Called(taskID, userIDs)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) RemoveWatcher(taskID, userID uint) error {
+	args := m.Called(taskID, userID)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) FindWatchers(taskID uint) ([]entity.Watcher, error) {
+	args := m.Called(taskID)
+	return args.Get(0).([]entity.Watcher), args.Error(1)
+}
+
+func (m *MockTaskRepository) CreateMentions(entries []entity.Mention) error {
+	args := m.Called(entries)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) FindMentions(userID uint, page, limit int) ([]entity.Mention, int64, error) {
+	args := m.Called(userID, page, limit)
+	return args.Get(0).([]entity.Mention), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockTaskRepository) FindTrash(page, limit int) ([]entity.Task, int64, error) {
+	args := m.Called(page, limit)
+	return args.Get(0).([]entity.Task), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockTaskRepository) FindByIDUnscoped(id uint) (entity.Task, error) {
+	args := m.Called(id)
+	return args.Get(0).(entity.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) Restore(id uint, userID *uint) error {
+	args := m.Called(id, userID)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) HardDelete(id uint) ([]string, error) {
+	args := m.Called(id)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockTaskRepository) PurgeDeleted(before time.Time) (int64, []string, error) {
+	args := m.Called(before)
+	return args.Get(0).(int64), args.Get(1).([]string), args.Error(2)
+}
+
+func (m *MockTaskRepository) ArchiveDone(before time.Time) (int64, error) {
+	args := m.Called(before)
+	return args.Get(0).(int64), args.Error(1)
+}
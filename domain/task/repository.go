@@ -1,18 +1,59 @@
 package task
 
-import "task_mng/domain/task/entity"
+import (
+	"errors"
+	"task_mng/domain/task/entity"
+	"time"
+)
+
+// ErrVersionConflict is returned by Update when the task changed since it was read
+var ErrVersionConflict = errors.New("task version conflict")
 
 type Filter struct {
 	Assignee *uint            `json:"assignee,omitempty"`
+	Reporter *uint            `json:"reporter,omitempty"`
 	Status   *entity.Status   `json:"status,omitempty"`
 	Priority *entity.Priority `json:"priority,omitempty"`
+	Sprint   *uint            `json:"sprint,omitempty"`
+	Project  *uint            `json:"project,omitempty"`
+	// Archived tasks are left out unless IncludeArchived is set
+	IncludeArchived bool `json:"include_archived,omitempty"`
+	// Custom field values compared as text, list values match when they contain the value
+	CustomFields map[string]string `json:"custom_fields,omitempty"`
 }
 
 type Repository interface {
 	Create(e *entity.Task) error
 	Update(e entity.Task) error
+	UpdateFields(id uint, fields map[string]interface{}) error
+	UpdateTimeTracking(id uint, logged int, remaining *int) error
 	FindByID(id uint) (entity.Task, error)
 	FindAll(filter *Filter, page, limit int) ([]entity.Task, int64, error)
 	Delete(e entity.Task) error
 	CountByStatus() (map[entity.Status]int64, error)
+	FindDue(before time.Time) ([]entity.Task, error)
+	FindByIDs(ids []uint) ([]entity.Task, error)
+	FindBySprintID(sprintID uint) ([]entity.Task, error)
+	CreateHistory(entries []entity.History) error
+	FindHistory(taskIDs []uint, field string) ([]entity.History, error)
+	FindHistoryByOldValue(field, value string) ([]entity.History, error)
+	FindBoard(filter *Filter) ([]entity.Task, error)
+	FindByStatus(status entity.Status) ([]entity.Task, error)
+	LastRank(status entity.Status) (string, error)
+	Rebalance(id uint, fields map[string]interface{}, ranks map[uint]string) error
+	FindColumn(status entity.Status) (entity.Column, error)
+	FindColumns() ([]entity.Column, error)
+	SaveColumn(e entity.Column) error
+	AddWatchers(taskID uint, userIDs []uint) error
+	RemoveWatcher(taskID, userID uint) error
+	FindWatchers(taskID uint) ([]entity.Watcher, error)
+	CreateMentions(entries []entity.Mention) error
+	FindMentions(userID uint, page, limit int) ([]entity.Mention, int64, error)
+	FindTrash(page, limit int) ([]entity.Task, int64, error)
+	FindByIDUnscoped(id uint) (entity.Task, error)
+	Restore(id uint, userID *uint) error
+	// HardDelete and PurgeDeleted return the storage keys of the removed attachments, their files are left to the caller
+	HardDelete(id uint) ([]string, error)
+	PurgeDeleted(before time.Time) (int64, []string, error)
+	ArchiveDone(before time.Time) (int64, error)
 }
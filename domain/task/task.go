@@ -1,10 +1,15 @@
 package task
 
 import (
+	attachmentEntity "task_mng/domain/attachment/entity"
+	checklistEntity "task_mng/domain/checklist/entity"
 	"task_mng/domain/task/entity"
+	worklogEntity "task_mng/domain/worklog/entity"
 	"task_mng/pkg/postgres"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type repository struct {
@@ -15,7 +20,20 @@ func New(db *postgres.Database) Repository {
 	return &repository{db: db}
 }
 
+// Create stores the task, a task without rank is appended to the end of its status column
 func (r *repository) Create(e *entity.Task) error {
+	if e.Rank == "" {
+		last, err := r.LastRank(e.Status)
+		if err != nil {
+			return err
+		}
+
+		e.Rank, err = entity.RankBetween(last, "")
+		if err != nil {
+			return err
+		}
+	}
+
 	return r.db.Create(e).Error
 }
 
@@ -42,14 +60,46 @@ func (r *repository) FindAll(filter *Filter, page, limit int) ([]entity.Task, in
 	return tasks, count, err
 }
 
+// Update saves every field of the task unless its version changed since it was read
 func (r *repository) Update(e entity.Task) error {
-	return r.db.Save(&e).Error
+	version := e.Version
+	e.Version++
+
+	result := r.db.Model(&e).Where("version = ?", version).Select("*").Updates(&e)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+// UpdateFields changes some fields of the task regardless of its version and bumps the version
+func (r *repository) UpdateFields(id uint, fields map[string]interface{}) error {
+	return updateFields(r.db.DB, id, fields)
+}
+
+// UpdateTimeTracking sums the work logs of the task into its time spent, the remaining estimate is set when
+// given and reduced by the logged minutes otherwise. Both are computed in the update so concurrent logs add up
+func (r *repository) UpdateTimeTracking(id uint, logged int, remaining *int) error {
+	var estimate interface{} = gorm.Expr("GREATEST(remaining_estimate - ?, 0)", logged)
+	if remaining != nil {
+		estimate = *remaining
+	}
+
+	return r.UpdateFields(id, map[string]interface{}{
+		"time_spent": gorm.Expr("(?)", r.db.Model(&worklogEntity.Worklog{}).
+			Select("COALESCE(SUM(minutes), 0)").Where("task_id = ?", id)),
+		"remaining_estimate": estimate,
+	})
 }
 
 func (r *repository) Delete(e entity.Task) error {
 	return r.db.Delete(&e).Error
 }
 
+// CountByStatus counts the tasks of each status, archived tasks are not counted
 func (r *repository) CountByStatus() (map[entity.Status]int64, error) {
 	counts := make(map[entity.Status]int64)
 
@@ -58,7 +108,7 @@ func (r *repository) CountByStatus() (map[entity.Status]int64, error) {
 
 	for _, status := range statuses {
 		var count int64
-		err := r.db.Model(&entity.Task{}).Where("status = ?", status).Count(&count).Error
+		err := r.db.Model(&entity.Task{}).Where("status = ? AND archived_at IS NULL", status).Count(&count).Error
 		if err != nil {
 			return nil, err
 		}
@@ -68,10 +118,273 @@ func (r *repository) CountByStatus() (map[entity.Status]int64, error) {
 	return counts, nil
 }
 
+// FindDue returns unfinished and unarchived tasks with a due date set up to the given time
+func (r *repository) FindDue(before time.Time) ([]entity.Task, error) {
+	var tasks []entity.Task
+	err := r.db.Where("status <> ? AND archived_at IS NULL AND due_date > ? AND due_date <= ?", entity.StatusDone, time.Time{}, before).
+		Order("due_date ASC").
+		Find(&tasks).Error
+	return tasks, err
+}
+
+func (r *repository) FindByIDs(ids []uint) ([]entity.Task, error) {
+	var tasks []entity.Task
+	err := r.db.Where("id IN ?", ids).Find(&tasks).Error
+	return tasks, err
+}
+
+func (r *repository) FindBySprintID(sprintID uint) ([]entity.Task, error) {
+	var tasks []entity.Task
+	err := r.db.Where("sprint_id = ?", sprintID).Order("id ASC").Find(&tasks).Error
+	return tasks, err
+}
+
+func (r *repository) CreateHistory(entries []entity.History) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	return r.db.Create(&entries).Error
+}
+
+// FindHistory returns the changes of a field for the given tasks in chronological order
+func (r *repository) FindHistory(taskIDs []uint, field string) ([]entity.History, error) {
+	var entries []entity.History
+	err := r.db.Where("task_id IN ? AND field = ?", taskIDs, field).Order("created_at ASC, id ASC").Find(&entries).Error
+	return entries, err
+}
+
+// FindHistoryByOldValue returns the changes of a field away from the given value
+func (r *repository) FindHistoryByOldValue(field, value string) ([]entity.History, error) {
+	var entries []entity.History
+	err := r.db.Where("field = ? AND old_value = ?", field, value).Order("created_at ASC, id ASC").Find(&entries).Error
+	return entries, err
+}
+
+// FindBoard returns the filtered tasks ordered by status column and rank
+func (r *repository) FindBoard(filter *Filter) ([]entity.Task, error) {
+	var tasks []entity.Task
+	err := r.buildQuery(filter).Order("status ASC, rank ASC, id ASC").Find(&tasks).Error
+	return tasks, err
+}
+
+// FindByStatus returns the tasks of a status column ordered by rank
+func (r *repository) FindByStatus(status entity.Status) ([]entity.Task, error) {
+	var tasks []entity.Task
+	err := r.db.Where("status = ?", status).Order("rank ASC, id ASC").Find(&tasks).Error
+	return tasks, err
+}
+
+// LastRank returns the highest rank of a status column, empty when the column is empty
+func (r *repository) LastRank(status entity.Status) (string, error) {
+	var ranks []string
+	err := r.db.Model(&entity.Task{}).Where("status = ?", status).Order("rank DESC").Limit(1).Pluck("rank", &ranks).Error
+	if err != nil || len(ranks) == 0 {
+		return "", err
+	}
+	return ranks[0], nil
+}
+
+// Rebalance changes some fields of the task and the ranks of the other tasks of its column in one transaction,
+// the version of every task is bumped
+func (r *repository) Rebalance(id uint, fields map[string]interface{}, ranks map[uint]string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for other, rank := range ranks {
+			if err := updateFields(tx, other, map[string]interface{}{"rank": rank}); err != nil {
+				return err
+			}
+		}
+		return updateFields(tx, id, fields)
+	})
+}
+
+func (r *repository) FindColumn(status entity.Status) (entity.Column, error) {
+	var column entity.Column
+	err := r.db.Where("status = ?", status).First(&column).Error
+	return column, err
+}
+
+func (r *repository) FindColumns() ([]entity.Column, error) {
+	var columns []entity.Column
+	err := r.db.Find(&columns).Error
+	return columns, err
+}
+
+func (r *repository) SaveColumn(e entity.Column) error {
+	return r.db.Save(&e).Error
+}
+
+// AddWatchers makes the users watch the task, users already watching it are skipped
+func (r *repository) AddWatchers(taskID uint, userIDs []uint) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	watchers := make([]entity.Watcher, len(userIDs))
+	for i, userID := range userIDs {
+		watchers[i] = entity.Watcher{TaskID: taskID, UserID: userID}
+	}
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&watchers).Error
+}
+
+func (r *repository) RemoveWatcher(taskID, userID uint) error {
+	return r.db.Where("task_id = ? AND user_id = ?", taskID, userID).Delete(&entity.Watcher{}).Error
+}
+
+func (r *repository) FindWatchers(taskID uint) ([]entity.Watcher, error) {
+	var watchers []entity.Watcher
+	err := r.db.Where("task_id = ?", taskID).Order("created_at ASC").Find(&watchers).Error
+	return watchers, err
+}
+
+func (r *repository) CreateMentions(entries []entity.Mention) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	return r.db.Create(&entries).Error
+}
+
+// FindMentions returns the mentions of a user, newest first
+func (r *repository) FindMentions(userID uint, page, limit int) ([]entity.Mention, int64, error) {
+	var mentions []entity.Mention
+	var count int64
+
+	offset := (page - 1) * limit
+
+	query := r.db.Model(&entity.Mention{}).Where("user_id = ?", userID)
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return mentions, count, err
+	}
+
+	err = query.Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&mentions).Error
+	return mentions, count, err
+}
+
+// FindTrash returns the soft deleted tasks, most recently deleted first
+func (r *repository) FindTrash(page, limit int) ([]entity.Task, int64, error) {
+	var tasks []entity.Task
+	var count int64
+
+	offset := (page - 1) * limit
+
+	query := r.db.Unscoped().Model(&entity.Task{}).Where("deleted_at IS NOT NULL")
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return tasks, count, err
+	}
+
+	err = query.Order("deleted_at DESC, id DESC").Offset(offset).Limit(limit).Find(&tasks).Error
+	return tasks, count, err
+}
+
+// FindByIDUnscoped finds a task whether it is deleted or not
+func (r *repository) FindByIDUnscoped(id uint) (entity.Task, error) {
+	var task entity.Task
+	err := r.db.Unscoped().Where("id = ?", id).First(&task).Error
+	return task, err
+}
+
+func (r *repository) Restore(id uint, userID *uint) error {
+	return r.db.Unscoped().Model(&entity.Task{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"deleted_at": nil,
+		"updated_by": userID,
+		"version":    gorm.Expr("version + 1"),
+	}).Error
+}
+
+// HardDelete removes the task and everything recorded on it for good
+func (r *repository) HardDelete(id uint) ([]string, error) {
+	var keys []string
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		keys, err = purge(tx, []uint{id})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// PurgeDeleted hard deletes the tasks soft deleted before the given time and returns how many were removed
+func (r *repository) PurgeDeleted(before time.Time) (int64, []string, error) {
+	var ids []uint
+	var keys []string
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Unscoped().Model(&entity.Task{}).Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Pluck("id", &ids).Error
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		keys, err = purge(tx, ids)
+		return err
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return int64(len(ids)), keys, nil
+}
+
+// ArchiveDone archives the done tasks completed before the given time and returns how many were archived
+// Tasks completed before completion times were recorded fall back to their last update
+func (r *repository) ArchiveDone(before time.Time) (int64, error) {
+	result := r.db.Model(&entity.Task{}).
+		Where("status = ? AND archived_at IS NULL AND COALESCE(completed_at, updated_at) < ?", entity.StatusDone, before).
+		UpdateColumns(map[string]interface{}{
+			"archived_at": time.Now().UTC(),
+			"version":     gorm.Expr("version + 1"),
+		})
+	return result.RowsAffected, result.Error
+}
+
 // Helper functions
+
+// updateFields changes some fields of a task and bumps its version
+func updateFields(db *gorm.DB, id uint, fields map[string]interface{}) error {
+	changes := make(map[string]interface{}, len(fields)+1)
+	for key, value := range fields {
+		changes[key] = value
+	}
+	changes["version"] = gorm.Expr("version + 1")
+
+	return db.Model(&entity.Task{}).Where("id = ?", id).Updates(changes).Error
+}
+
+// purge deletes the tasks with their records and returns the storage keys of their attachments
+// Attachments deleted earlier already had their files removed, so only the live ones are returned
+func purge(tx *gorm.DB, ids []uint) ([]string, error) {
+	var keys []string
+	if err := tx.Model(&attachmentEntity.Attachment{}).Where("task_id IN ?", ids).Pluck("storage_key", &keys).Error; err != nil {
+		return nil, err
+	}
+
+	for _, model := range []interface{}{&entity.History{}, &entity.Watcher{}, &entity.Mention{}} {
+		if err := tx.Where("task_id IN ?", ids).Delete(model).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	// These are soft deleted otherwise
+	for _, model := range []interface{}{&checklistEntity.Item{}, &worklogEntity.Worklog{}, &attachmentEntity.Attachment{}} {
+		if err := tx.Unscoped().Where("task_id IN ?", ids).Delete(model).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Unscoped().Where("id IN ?", ids).Delete(&entity.Task{}).Error; err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
 func (r *repository) buildQuery(filter *Filter) *gorm.DB {
 	query := r.db.Model(&entity.Task{})
 
+	if !filter.IncludeArchived {
+		query = query.Where("archived_at IS NULL")
+	}
+
 	if filter.Assignee != nil {
 		query = query.Where("assignee = ?", *filter.Assignee)
 	}
@@ -84,5 +397,21 @@ func (r *repository) buildQuery(filter *Filter) *gorm.DB {
 		query = query.Where("priority = ?", *filter.Priority)
 	}
 
+	if filter.Reporter != nil {
+		query = query.Where("reporter = ?", *filter.Reporter)
+	}
+
+	if filter.Sprint != nil {
+		query = query.Where("sprint_id = ?", *filter.Sprint)
+	}
+
+	if filter.Project != nil {
+		query = query.Where("project_id = ?", *filter.Project)
+	}
+
+	for key, value := range filter.CustomFields {
+		query = query.Where("(custom_fields ->> ? = ? OR custom_fields -> ? @> jsonb_build_array(?::text))", key, value, key, value)
+	}
+
 	return query
 }
@@ -0,0 +1,48 @@
+package aggregate
+
+import (
+	taskEntity "task_mng/domain/task/entity"
+	"task_mng/domain/template/entity"
+	"task_mng/pkg/response"
+	"time"
+)
+
+type TemplateResponse struct {
+	ID          uint                `json:"id"`
+	Name        string              `json:"name"`
+	Summary     string              `json:"summary"`
+	Description string              `json:"description"`
+	Priority    taskEntity.Priority `json:"priority"`
+	Labels      []string            `json:"labels"`
+	Checklist   []string            `json:"checklist"`
+	CreatedAt   time.Time           `json:"created_at"`
+}
+
+func NewTemplateResponse(template *entity.Template) *TemplateResponse {
+	return &TemplateResponse{
+		ID:          template.ID,
+		Name:        template.Name,
+		Summary:     template.Summary,
+		Description: template.Description,
+		Priority:    template.Priority,
+		Labels:      template.Labels,
+		Checklist:   template.Checklist,
+		CreatedAt:   template.CreatedAt,
+	}
+}
+
+type TemplateListResponse struct {
+	Templates []*TemplateResponse `json:"templates"`
+	Meta      *response.Meta      `json:"-"`
+}
+
+func NewTemplateListResponse(templates []entity.Template, page, limit int, count int64, sort string) *TemplateListResponse {
+	templateResponses := make([]*TemplateResponse, len(templates))
+	for i, template := range templates {
+		templateResponses[i] = NewTemplateResponse(&template)
+	}
+	return &TemplateListResponse{
+		Templates: templateResponses,
+		Meta:      response.NewMeta(page, limit, int(count), sort),
+	}
+}
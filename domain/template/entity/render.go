@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// variablePattern matches placeholders like {{customer}} or {{ incident_id }}
+var variablePattern = regexp.MustCompile(`{{\s*([a-zA-Z0-9_]+)\s*}}`)
+
+// Render replaces the {{name}} placeholders of the text with the given variables
+// Returns an error listing the placeholders without a value
+func Render(text string, variables map[string]string) (string, error) {
+	missing := make(map[string]bool)
+
+	result := variablePattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+		name := variablePattern.FindStringSubmatch(placeholder)[1]
+		value, ok := variables[name]
+		if !ok {
+			missing[name] = true
+			return placeholder
+		}
+		return value
+	})
+
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return "", fmt.Errorf("missing template variables: %s", strings.Join(names, ", "))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,32 @@
+package entity_test
+
+import (
+	"task_mng/domain/template/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	result, err := entity.Render("Postmortem for {{incident}} ({{ customer }})", map[string]string{
+		"incident": "INC-42",
+		"customer": "ACME",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Postmortem for INC-42 (ACME)", result)
+}
+
+func TestRender_NoPlaceholders(t *testing.T) {
+	result, err := entity.Render("Onboarding", nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Onboarding", result)
+}
+
+func TestRender_MissingVariables(t *testing.T) {
+	_, err := entity.Render("{{name}} joins {{team}}", map[string]string{})
+
+	assert.Error(t, err)
+	assert.Equal(t, "missing template variables: name, team", err.Error())
+}
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"task_mng/domain/task/entity"
+
+	"gorm.io/gorm"
+)
+
+// Template holds the defaults used to create tasks of the same kind
+type Template struct {
+	gorm.Model
+	Name        string          `gorm:"not null"` // unique among the templates that aren't deleted
+	Summary     string          `gorm:"not null"`
+	Description string          `gorm:"not null"`
+	Priority    entity.Priority `gorm:"not null"`
+	Labels      []string        `gorm:"type:jsonb;serializer:json"`
+	Checklist   []string        `gorm:"type:jsonb;serializer:json"` // default checklist items
+}
+
+func (Template) TableName() string {
+	return "templates"
+}
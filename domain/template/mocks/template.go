@@ -0,0 +1,41 @@
+package mocks
+
+import (
+	"task_mng/domain/template/entity"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTemplateRepository struct {
+	mock.Mock
+}
+
+func (m *MockTemplateRepository) Create(e *entity.Template) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockTemplateRepository) Update(e entity.Template) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockTemplateRepository) FindByID(id uint) (entity.Template, error) {
+	args := m.Called(id)
+	return args.Get(0).(entity.Template), args.Error(1)
+}
+
+func (m *MockTemplateRepository) FindByName(name string) (entity.Template, error) {
+	args := m.Called(name)
+	return args.Get(0).(entity.Template), args.Error(1)
+}
+
+func (m *MockTemplateRepository) FindAll(page, limit int) ([]entity.Template, int64, error) {
+	args := m.Called(page, limit)
+	return args.Get(0).([]entity.Template), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockTemplateRepository) Delete(e entity.Template) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
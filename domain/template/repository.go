@@ -0,0 +1,12 @@
+package template
+
+import "task_mng/domain/template/entity"
+
+type Repository interface {
+	Create(e *entity.Template) error
+	Update(e entity.Template) error
+	FindByID(id uint) (entity.Template, error)
+	FindByName(name string) (entity.Template, error)
+	FindAll(page, limit int) ([]entity.Template, int64, error)
+	Delete(e entity.Template) error
+}
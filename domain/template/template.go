@@ -0,0 +1,53 @@
+package template
+
+import (
+	"task_mng/domain/template/entity"
+	"task_mng/pkg/postgres"
+)
+
+type repository struct {
+	db *postgres.Database
+}
+
+func New(db *postgres.Database) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Create(e *entity.Template) error {
+	return r.db.Create(e).Error
+}
+
+func (r *repository) Update(e entity.Template) error {
+	return r.db.Save(&e).Error
+}
+
+func (r *repository) FindByID(id uint) (entity.Template, error) {
+	var template entity.Template
+	err := r.db.Where("id = ?", id).First(&template).Error
+	return template, err
+}
+
+func (r *repository) FindByName(name string) (entity.Template, error) {
+	var template entity.Template
+	err := r.db.Where("name = ?", name).First(&template).Error
+	return template, err
+}
+
+func (r *repository) FindAll(page, limit int) ([]entity.Template, int64, error) {
+	var templates []entity.Template
+	var count int64
+
+	offset := (page - 1) * limit
+
+	err := r.db.Model(&entity.Template{}).Count(&count).Error
+	if err != nil {
+		return templates, count, err
+	}
+
+	err = r.db.Order("name ASC").Offset(offset).Limit(limit).Find(&templates).Error
+	return templates, count, err
+}
+
+func (r *repository) Delete(e entity.Template) error {
+	return r.db.Delete(&e).Error
+}
@@ -0,0 +1,42 @@
+package aggregate
+
+import (
+	"task_mng/domain/user/entity"
+	"time"
+)
+
+type AccessTokenResponse struct {
+	ID         uint       `json:"id"`
+	Name       string     `json:"name"`
+	Scopes     []string   `json:"scopes"`
+	Hint       string     `json:"hint"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
+
+// CreatedAccessTokenResponse carries the token itself, it is only ever shown when it is created
+type CreatedAccessTokenResponse struct {
+	*AccessTokenResponse
+	Token string `json:"token"`
+}
+
+func NewAccessTokenResponse(token *entity.AccessToken) *AccessTokenResponse {
+	return &AccessTokenResponse{
+		ID:         token.ID,
+		Name:       token.Name,
+		Scopes:     token.Scopes,
+		Hint:       token.Hint,
+		CreatedAt:  token.CreatedAt,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+	}
+}
+
+func NewAccessTokenListResponse(tokens []entity.AccessToken) []*AccessTokenResponse {
+	responses := make([]*AccessTokenResponse, len(tokens))
+	for i := range tokens {
+		responses[i] = NewAccessTokenResponse(&tokens[i])
+	}
+	return responses
+}
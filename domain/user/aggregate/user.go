@@ -7,11 +7,14 @@ import (
 )
 
 type UserResponse struct {
-	ID           uint      `json:"id"`
-	FullName     string    `json:"full_name"`
-	Username     string    `json:"username"`
-	Email        string    `json:"email"`
-	RegisteredAt time.Time `json:"registered_at"`
+	ID              uint       `json:"id"`
+	FullName        string     `json:"full_name"`
+	Username        string     `json:"username"`
+	Email           string     `json:"email"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+	Role            string     `json:"role"`
+	RegisteredAt    time.Time  `json:"registered_at"`
+	LockedUntil     *time.Time `json:"locked_until,omitempty"`
 }
 
 type UserListResponse struct {
@@ -21,11 +24,14 @@ type UserListResponse struct {
 
 func NewUserResponse(user *entity.User) *UserResponse {
 	return &UserResponse{
-		ID:           user.ID,
-		FullName:     user.FullName,
-		Username:     user.Username,
-		Email:        user.Email,
-		RegisteredAt: user.CreatedAt,
+		ID:              user.ID,
+		FullName:        user.FullName,
+		Username:        user.Username,
+		Email:           user.Email,
+		EmailVerifiedAt: user.EmailVerifiedAt,
+		Role:            user.Role,
+		RegisteredAt:    user.CreatedAt,
+		LockedUntil:     user.LockedUntil,
 	}
 }
 
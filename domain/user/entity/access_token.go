@@ -0,0 +1,52 @@
+package entity
+
+import "time"
+
+const (
+	// AccessTokenPrefix starts every personal access token, it tells them apart from JWTs
+	AccessTokenPrefix = "tm_pat_"
+
+	// ScopeRead allows reading, i.e. GET requests
+	ScopeRead = "read"
+	// ScopeWrite allows every other request
+	ScopeWrite = "write"
+	// ScopeAdmin allows the admin endpoints, the owner has to be an admin too
+	ScopeAdmin = "admin"
+)
+
+// Scopes are the scopes a personal access token can have
+var Scopes = []string{ScopeRead, ScopeWrite, ScopeAdmin}
+
+// AccessToken is a personal access token a user creates for automation, only the hash of the token is stored
+type AccessToken struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	UserID    uint     `gorm:"not null;index"`
+	Name      string   `gorm:"not null"`
+	Scopes    []string `gorm:"type:jsonb;serializer:json"`
+	// Hint is the end of the token so users can tell their tokens apart
+	Hint       string `gorm:"not null"`
+	TokenHash  string `gorm:"not null;uniqueIndex"`
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+func (AccessToken) TableName() string {
+	return "personal_access_tokens"
+}
+
+// IsActive reports whether the token can be used at the given time
+func (t AccessToken) IsActive(now time.Time) bool {
+	return t.RevokedAt == nil && (t.ExpiresAt == nil || now.Before(*t.ExpiresAt))
+}
+
+// HasScope reports whether the token was given the scope
+func (t AccessToken) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+const (
+	LockoutEventLocked   = "locked"
+	LockoutEventUnlocked = "unlocked"
+)
+
+// LockoutEvent records an account being locked after failed logins or unlocked by an admin
+type LockoutEvent struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	UserID    uint   `gorm:"not null;index"`
+	Event     string `gorm:"not null"`
+	// IP is the address of the failed login that locked the account
+	IP          string
+	LockedUntil *time.Time
+	// ActorID is the admin that unlocked the account
+	ActorID *uint
+}
+
+func (LockoutEvent) TableName() string {
+	return "user_lockout_events"
+}
@@ -1,15 +1,42 @@
 package entity
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
 type User struct {
 	gorm.Model
 	FullName string `gorm:"not null"`
 	Username string `gorm:"not null;unique"`
+	// Email is unique regardless of case, see the idx_users_email_lower index created by the migration
 	Email    string `gorm:"not null"`
 	Password string `gorm:"not null"`
+	Role     string `gorm:"not null;default:user"`
+	// EmailVerifiedAt is unset until the user verifies their email, changing the email unsets it again
+	EmailVerifiedAt *time.Time
+
+	// FailedLogins counts the failed logins since the last successful one or lockout
+	FailedLogins int `gorm:"not null;default:0"`
+	// Lockouts counts the lockouts since the last successful login, every lockout lasts twice as long as the previous one
+	Lockouts    int `gorm:"not null;default:0"`
+	LockedUntil *time.Time
+}
+
+// IsEmailVerified reports whether the user verified their current email
+func (u User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// IsLocked reports whether the account is locked at the given time
+func (u User) IsLocked(now time.Time) bool {
+	return u.LockedUntil != nil && now.Before(*u.LockedUntil)
 }
 
 func NewUser(username, fullName, email, password string) (User, error) {
@@ -18,6 +45,7 @@ func NewUser(username, fullName, email, password string) (User, error) {
 			FullName: fullName,
 			Email:    email,
 			Password: password,
+			Role:     RoleUser,
 		},
 		nil
 }
@@ -0,0 +1,24 @@
+package entity
+
+import "time"
+
+// EmailVerificationToken is a single use token proving access to an email, only the hash of the token is stored
+type EmailVerificationToken struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	UserID    uint `gorm:"not null;index"`
+	// Email is the address the token was sent to, it only verifies the user while that is still their email
+	Email     string    `gorm:"not null"`
+	TokenHash string    `gorm:"not null;uniqueIndex"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+}
+
+func (EmailVerificationToken) TableName() string {
+	return "email_verification_tokens"
+}
+
+// IsUsable reports whether the token can still be used at the given time
+func (t EmailVerificationToken) IsUsable(now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiresAt)
+}
@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"task_mng/domain/user/entity"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -50,3 +51,88 @@ func (m *MockUserRepository) Delete(id uint) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
+
+func (m *MockUserRepository) UpdateFields(id uint, fields map[string]interface{}) error {
+	args := m.Called(id, fields)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) IncrementFailedLogins(id uint) (int, error) {
+	args := m.Called(id)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockUserRepository) CreateLockoutEvent(e *entity.LockoutEvent) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) CreateResetToken(e *entity.PasswordResetToken) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) FindResetToken(tokenHash string) (entity.PasswordResetToken, error) {
+	args := m.Called(tokenHash)
+	return args.Get(0).(entity.PasswordResetToken), args.Error(1)
+}
+
+func (m *MockUserRepository) UseResetToken(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserRepository) InvalidateResetTokens(userID uint) error {
+	args := m.Called(userID)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) CreateVerificationToken(e *entity.EmailVerificationToken) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) FindVerificationToken(tokenHash string) (entity.EmailVerificationToken, error) {
+	args := m.Called(tokenHash)
+	return args.Get(0).(entity.EmailVerificationToken), args.Error(1)
+}
+
+func (m *MockUserRepository) UseVerificationToken(id uint) (bool, error) {
+	args := m.Called(id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserRepository) InvalidateVerificationTokens(userID uint) error {
+	args := m.Called(userID)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) CreateAccessToken(e *entity.AccessToken) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) FindAccessToken(tokenHash string) (entity.AccessToken, error) {
+	args := m.Called(tokenHash)
+	return args.Get(0).(entity.AccessToken), args.Error(1)
+}
+
+func (m *MockUserRepository) FindAccessTokens(userID uint) ([]entity.AccessToken, error) {
+	args := m.Called(userID)
+	return args.Get(0).([]entity.AccessToken), args.Error(1)
+}
+
+func (m *MockUserRepository) RevokeAccessToken(userID, id uint) (bool, error) {
+	args := m.Called(userID, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserRepository) RevokeAccessTokens(userID uint) error {
+	args := m.Called(userID)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) TouchAccessToken(id uint, usedAt time.Time) error {
+	args := m.Called(id, usedAt)
+	return args.Error(0)
+}
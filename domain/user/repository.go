@@ -1,14 +1,47 @@
 package user
 
-import "task_mng/domain/user/entity"
+import (
+	"task_mng/domain/user/entity"
+	"time"
+)
 
 type Repository interface {
 	Create(e *entity.User) error
+	// FindByEmail finds the user with the email regardless of case
 	FindByEmail(email string) (entity.User, error)
 	FindByUsername(username string) (entity.User, error)
 	FindByID(id uint) (entity.User, error)
 	FindByIDs(ids []uint) ([]entity.User, error)
 	FindAll(page, limit int) ([]entity.User, int64, error)
 	Update(e entity.User) error
+	UpdateFields(id uint, fields map[string]interface{}) error
+	// IncrementFailedLogins counts a failed login of the user in a single statement and returns the new count
+	IncrementFailedLogins(id uint) (int, error)
 	Delete(id uint) error
+
+	CreateLockoutEvent(e *entity.LockoutEvent) error
+
+	CreateResetToken(e *entity.PasswordResetToken) error
+	FindResetToken(tokenHash string) (entity.PasswordResetToken, error)
+	// UseResetToken marks the token as used, it returns false when it was used already
+	UseResetToken(id uint) (bool, error)
+	// InvalidateResetTokens marks all unused tokens of the user as used
+	InvalidateResetTokens(userID uint) error
+
+	CreateVerificationToken(e *entity.EmailVerificationToken) error
+	FindVerificationToken(tokenHash string) (entity.EmailVerificationToken, error)
+	// UseVerificationToken marks the token as used, it returns false when it was used already
+	UseVerificationToken(id uint) (bool, error)
+	// InvalidateVerificationTokens marks all unused tokens of the user as used
+	InvalidateVerificationTokens(userID uint) error
+
+	CreateAccessToken(e *entity.AccessToken) error
+	FindAccessToken(tokenHash string) (entity.AccessToken, error)
+	// FindAccessTokens returns the tokens of the user that aren't revoked, newest first
+	FindAccessTokens(userID uint) ([]entity.AccessToken, error)
+	// RevokeAccessToken revokes a token of the user, it returns false when the user has no such active token
+	RevokeAccessToken(userID, id uint) (bool, error)
+	// RevokeAccessTokens revokes all active tokens of the user
+	RevokeAccessTokens(userID uint) error
+	TouchAccessToken(id uint, usedAt time.Time) error
 }
@@ -3,6 +3,10 @@ package user
 import (
 	"task_mng/domain/user/entity"
 	"task_mng/pkg/postgres"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type repository struct {
@@ -19,7 +23,7 @@ func (r *repository) Create(e *entity.User) error {
 
 func (r *repository) FindByEmail(email string) (entity.User, error) {
 	var user entity.User
-	err := r.db.Where("email = ?", email).First(&user).Error
+	err := r.db.Where("LOWER(email) = LOWER(?)", email).First(&user).Error
 	return user, err
 }
 
@@ -63,6 +67,105 @@ func (r *repository) Update(e entity.User) error {
 	return r.db.Save(&e).Error
 }
 
+func (r *repository) UpdateFields(id uint, fields map[string]interface{}) error {
+	return r.db.Model(&entity.User{}).Where("id = ?", id).Updates(fields).Error
+}
+
+func (r *repository) IncrementFailedLogins(id uint) (int, error) {
+	var usr entity.User
+	result := r.db.Model(&usr).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "failed_logins"}}}).
+		Where("id = ?", id).
+		Update("failed_logins", gorm.Expr("failed_logins + 1"))
+	if result.Error == nil && result.RowsAffected == 0 {
+		return 0, gorm.ErrRecordNotFound
+	}
+	return usr.FailedLogins, result.Error
+}
+
 func (r *repository) Delete(id uint) error {
 	return r.db.Delete(&entity.User{}, id).Error
 }
+
+func (r *repository) CreateLockoutEvent(e *entity.LockoutEvent) error {
+	return r.db.Create(e).Error
+}
+
+func (r *repository) CreateResetToken(e *entity.PasswordResetToken) error {
+	return r.db.Create(e).Error
+}
+
+func (r *repository) FindResetToken(tokenHash string) (entity.PasswordResetToken, error) {
+	var token entity.PasswordResetToken
+	err := r.db.Where("token_hash = ?", tokenHash).First(&token).Error
+	return token, err
+}
+
+func (r *repository) UseResetToken(id uint) (bool, error) {
+	result := r.db.Model(&entity.PasswordResetToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now().UTC())
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r *repository) InvalidateResetTokens(userID uint) error {
+	return r.db.Model(&entity.PasswordResetToken{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Update("used_at", time.Now().UTC()).Error
+}
+
+func (r *repository) CreateVerificationToken(e *entity.EmailVerificationToken) error {
+	return r.db.Create(e).Error
+}
+
+func (r *repository) FindVerificationToken(tokenHash string) (entity.EmailVerificationToken, error) {
+	var token entity.EmailVerificationToken
+	err := r.db.Where("token_hash = ?", tokenHash).First(&token).Error
+	return token, err
+}
+
+func (r *repository) UseVerificationToken(id uint) (bool, error) {
+	result := r.db.Model(&entity.EmailVerificationToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now().UTC())
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r *repository) InvalidateVerificationTokens(userID uint) error {
+	return r.db.Model(&entity.EmailVerificationToken{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Update("used_at", time.Now().UTC()).Error
+}
+
+func (r *repository) CreateAccessToken(e *entity.AccessToken) error {
+	return r.db.Create(e).Error
+}
+
+func (r *repository) FindAccessToken(tokenHash string) (entity.AccessToken, error) {
+	var token entity.AccessToken
+	err := r.db.Where("token_hash = ?", tokenHash).First(&token).Error
+	return token, err
+}
+
+func (r *repository) FindAccessTokens(userID uint) ([]entity.AccessToken, error) {
+	var tokens []entity.AccessToken
+	err := r.db.Where("user_id = ? AND revoked_at IS NULL", userID).Order("created_at DESC").Find(&tokens).Error
+	return tokens, err
+}
+
+func (r *repository) RevokeAccessToken(userID, id uint) (bool, error) {
+	result := r.db.Model(&entity.AccessToken{}).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", id, userID).
+		Update("revoked_at", time.Now().UTC())
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r *repository) RevokeAccessTokens(userID uint) error {
+	return r.db.Model(&entity.AccessToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now().UTC()).Error
+}
+
+func (r *repository) TouchAccessToken(id uint, usedAt time.Time) error {
+	return r.db.Model(&entity.AccessToken{}).Where("id = ?", id).Update("last_used_at", usedAt).Error
+}
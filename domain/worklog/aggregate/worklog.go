@@ -0,0 +1,118 @@
+package aggregate
+
+import (
+	taskAggregate "task_mng/domain/task/aggregate"
+	"task_mng/domain/worklog/entity"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+type WorklogResponse struct {
+	ID        uint                       `json:"id"`
+	TaskID    uint                       `json:"task_id"`
+	User      taskAggregate.AssigneeInfo `json:"user"`
+	Minutes   int                        `json:"minutes"`
+	Date      string                     `json:"date"`
+	Note      string                     `json:"note"`
+	CreatedAt time.Time                  `json:"created_at"`
+}
+
+func NewWorklogResponse(worklog *entity.Worklog, username string) *WorklogResponse {
+	return &WorklogResponse{
+		ID:     worklog.ID,
+		TaskID: worklog.TaskID,
+		User: taskAggregate.AssigneeInfo{
+			ID:       worklog.UserID,
+			Username: username,
+		},
+		Minutes:   worklog.Minutes,
+		Date:      worklog.Date.Format(dateLayout),
+		Note:      worklog.Note,
+		CreatedAt: worklog.CreatedAt,
+	}
+}
+
+func NewWorklogListResponse(worklogs []entity.Worklog, usernames map[uint]string) []*WorklogResponse {
+	worklogResponses := make([]*WorklogResponse, len(worklogs))
+	for i, worklog := range worklogs {
+		worklogResponses[i] = NewWorklogResponse(&worklog, usernames[worklog.UserID])
+	}
+	return worklogResponses
+}
+
+// DayTotal is the time logged by a user on one day
+type DayTotal struct {
+	Date    string `json:"date"`
+	Minutes int    `json:"minutes"`
+}
+
+// TaskTotal is the time logged by a user on one task
+type TaskTotal struct {
+	TaskID  uint `json:"task_id"`
+	Minutes int  `json:"minutes"`
+}
+
+type UserTimesheet struct {
+	User         taskAggregate.AssigneeInfo `json:"user"`
+	TotalMinutes int                        `json:"total_minutes"`
+	Days         []DayTotal                 `json:"days"`
+	Tasks        []TaskTotal                `json:"tasks"`
+}
+
+type TimesheetResponse struct {
+	From         string          `json:"from"`
+	To           string          `json:"to"`
+	TotalMinutes int             `json:"total_minutes"`
+	Users        []UserTimesheet `json:"users"`
+}
+
+// NewTimesheetResponse sums the work logs per user, per day and per task
+// The work logs are expected to be ordered by user and date
+func NewTimesheetResponse(from, to time.Time, worklogs []entity.Worklog, usernames map[uint]string) *TimesheetResponse {
+	resp := &TimesheetResponse{
+		From:  from.Format(dateLayout),
+		To:    to.Format(dateLayout),
+		Users: make([]UserTimesheet, 0),
+	}
+
+	userIndex := make(map[uint]int)
+	taskIndex := make(map[uint]map[uint]int)
+
+	for _, worklog := range worklogs {
+		i, ok := userIndex[worklog.UserID]
+		if !ok {
+			i = len(resp.Users)
+			userIndex[worklog.UserID] = i
+			taskIndex[worklog.UserID] = make(map[uint]int)
+			resp.Users = append(resp.Users, UserTimesheet{
+				User: taskAggregate.AssigneeInfo{
+					ID:       worklog.UserID,
+					Username: usernames[worklog.UserID],
+				},
+				Days:  make([]DayTotal, 0),
+				Tasks: make([]TaskTotal, 0),
+			})
+		}
+		user := &resp.Users[i]
+
+		user.TotalMinutes += worklog.Minutes
+		resp.TotalMinutes += worklog.Minutes
+
+		date := worklog.Date.Format(dateLayout)
+		if n := len(user.Days); n > 0 && user.Days[n-1].Date == date {
+			user.Days[n-1].Minutes += worklog.Minutes
+		} else {
+			user.Days = append(user.Days, DayTotal{Date: date, Minutes: worklog.Minutes})
+		}
+
+		if j, ok := taskIndex[worklog.UserID][worklog.TaskID]; ok {
+			user.Tasks[j].Minutes += worklog.Minutes
+		} else {
+			taskIndex[worklog.UserID][worklog.TaskID] = len(user.Tasks)
+			user.Tasks = append(user.Tasks, TaskTotal{TaskID: worklog.TaskID, Minutes: worklog.Minutes})
+		}
+	}
+
+	return resp
+}
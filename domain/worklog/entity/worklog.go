@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Worklog struct {
+	gorm.Model
+	TaskID  uint      `gorm:"not null;index"`
+	UserID  uint      `gorm:"not null;index:idx_worklogs_user_date"`
+	Minutes int       `gorm:"not null"`
+	Date    time.Time `gorm:"type:date;not null;index:idx_worklogs_user_date"` // day the work was done
+	Note    string
+}
+
+func (w *Worklog) TableName() string {
+	return "worklogs"
+}
@@ -0,0 +1,37 @@
+package mocks
+
+import (
+	"task_mng/domain/worklog/entity"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockWorklogRepository struct {
+	mock.Mock
+}
+
+func (m *MockWorklogRepository) Create(e *entity.Worklog) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
+
+func (m *MockWorklogRepository) FindByID(id uint) (entity.Worklog, error) {
+	args := m.Called(id)
+	return args.Get(0).(entity.Worklog), args.Error(1)
+}
+
+func (m *MockWorklogRepository) FindByTaskID(taskID uint) ([]entity.Worklog, error) {
+	args := m.Called(taskID)
+	return args.Get(0).([]entity.Worklog), args.Error(1)
+}
+
+func (m *MockWorklogRepository) FindByPeriod(userID *uint, from, to time.Time) ([]entity.Worklog, error) {
+	args := m.Called(userID, from, to)
+	return args.Get(0).([]entity.Worklog), args.Error(1)
+}
+
+func (m *MockWorklogRepository) Delete(e entity.Worklog) error {
+	args := m.Called(e)
+	return args.Error(0)
+}
@@ -0,0 +1,14 @@
+package worklog
+
+import (
+	"task_mng/domain/worklog/entity"
+	"time"
+)
+
+type Repository interface {
+	Create(e *entity.Worklog) error
+	FindByID(id uint) (entity.Worklog, error)
+	FindByTaskID(taskID uint) ([]entity.Worklog, error)
+	FindByPeriod(userID *uint, from, to time.Time) ([]entity.Worklog, error)
+	Delete(e entity.Worklog) error
+}
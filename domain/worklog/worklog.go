@@ -0,0 +1,48 @@
+package worklog
+
+import (
+	"task_mng/domain/worklog/entity"
+	"task_mng/pkg/postgres"
+	"time"
+)
+
+type repository struct {
+	db *postgres.Database
+}
+
+func New(db *postgres.Database) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Create(e *entity.Worklog) error {
+	return r.db.Create(e).Error
+}
+
+func (r *repository) FindByID(id uint) (entity.Worklog, error) {
+	var worklog entity.Worklog
+	err := r.db.Where("id = ?", id).First(&worklog).Error
+	return worklog, err
+}
+
+func (r *repository) FindByTaskID(taskID uint) ([]entity.Worklog, error) {
+	var worklogs []entity.Worklog
+	err := r.db.Where("task_id = ?", taskID).Order("date ASC, id ASC").Find(&worklogs).Error
+	return worklogs, err
+}
+
+// FindByPeriod returns the work logs dated between from and to inclusive, optionally limited to a user
+func (r *repository) FindByPeriod(userID *uint, from, to time.Time) ([]entity.Worklog, error) {
+	var worklogs []entity.Worklog
+
+	query := r.db.Where("date >= ? AND date <= ?", from, to)
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+
+	err := query.Order("user_id ASC, date ASC, id ASC").Find(&worklogs).Error
+	return worklogs, err
+}
+
+func (r *repository) Delete(e entity.Worklog) error {
+	return r.db.Delete(&e).Error
+}
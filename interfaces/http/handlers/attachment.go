@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"task_mng/pkg/response"
+	"task_mng/services/attachment"
+
+	"github.com/gin-gonic/gin"
+)
+
+// multipartOverhead leaves room for the multipart boundaries and headers on top of the file itself
+const multipartOverhead = 1 << 20 // 1MB
+
+type AttachmentHandler struct {
+	attachmentService *attachment.Service
+}
+
+func NewAttachmentHandler(attachmentService *attachment.Service) *AttachmentHandler {
+	return &AttachmentHandler{attachmentService: attachmentService}
+}
+
+// Upload godoc
+// @Summary Upload a task attachment
+// @Description Upload a file as multipart form data, the content type is detected from the file content
+// @Tags Attachments
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param file formData file true "File to upload"
+// @Success 201 {object} response.Response{data=aggregate.AttachmentResponse} "created"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 413 {object} response.Response "File too large"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/attachments [post]
+func (h *AttachmentHandler) Upload(c *gin.Context) {
+	id := c.Param("id")
+	userID, _ := c.Get("user_id")
+
+	// Reject oversized bodies before they are buffered to memory or disk
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.attachmentService.MaxSize()+multipartOverhead)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			response.RequestEntityTooLarge(c, "file_too_large")
+			return
+		}
+		response.BadRequest(c, "file_is_required")
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		response.BadRequest(c, "file_is_required")
+		return
+	}
+	defer file.Close()
+
+	result, err := h.attachmentService.Upload(c.Request.Context(), id, userID.(uint), fileHeader.Filename, fileHeader.Size, file)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Created(c, result)
+}
+
+// FindAll godoc
+// @Summary List task attachments
+// @Description Get the attachments of a task with signed download links
+// @Tags Attachments
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} response.Response{data=[]aggregate.AttachmentResponse} "Attachments retrieved successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/attachments [get]
+func (h *AttachmentHandler) FindAll(c *gin.Context) {
+	id := c.Param("id")
+
+	result, err := h.attachmentService.FindAll(id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Attachments retrieved successfully", result, nil)
+}
+
+// Delete godoc
+// @Summary Delete a task attachment
+// @Description Delete an attachment and its stored file
+// @Tags Attachments
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param attachment_id path string true "Attachment ID"
+// @Success 200 {object} response.Response "Attachment deleted successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task or attachment not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/attachments/{attachment_id} [delete]
+func (h *AttachmentHandler) Delete(c *gin.Context) {
+	id := c.Param("id")
+	attachmentID := c.Param("attachment_id")
+
+	err := h.attachmentService.Delete(c.Request.Context(), id, attachmentID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Attachment deleted successfully", nil, nil)
+}
+
+// Download godoc
+// @Summary Download an attachment
+// @Description Download the attachment content using the signed link returned by the attachment endpoints
+// @Tags Attachments
+// @Produce octet-stream
+// @Param id path string true "Attachment ID"
+// @Param expires query string true "Link expiry as unix timestamp"
+// @Param signature query string true "Link signature"
+// @Success 200 {file} file "Attachment content"
+// @Failure 403 {object} response.Response "Invalid or expired link"
+// @Failure 404 {object} response.Response "Attachment not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /attachments/{id}/download [get]
+func (h *AttachmentHandler) Download(c *gin.Context) {
+	id := c.Param("id")
+
+	e, reader, err := h.attachmentService.Download(c.Request.Context(), id, c.Query("expires"), c.Query("signature"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	defer reader.Close()
+
+	c.DataFromReader(http.StatusOK, e.Size, e.ContentType, reader, map[string]string{
+		"Content-Disposition":    fmt.Sprintf("attachment; filename=%q", e.FileName),
+		"X-Content-Type-Options": "nosniff",
+	})
+}
@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"task_mng/pkg/response"
+	"task_mng/services/checklist"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ChecklistHandler struct {
+	checklistService *checklist.Service
+}
+
+func NewChecklistHandler(checklistService *checklist.Service) *ChecklistHandler {
+	return &ChecklistHandler{checklistService: checklistService}
+}
+
+// FindAll godoc
+// @Summary List checklist items of a task
+// @Description Get the ordered checklist items of a task
+// @Tags Checklists
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} response.Response{data=[]aggregate.ItemResponse} "Checklist retrieved successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/checklist [get]
+func (h *ChecklistHandler) FindAll(c *gin.Context) {
+	id := c.Param("id")
+
+	result, err := h.checklistService.FindAll(id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Checklist retrieved successfully", result, nil)
+}
+
+// Create godoc
+// @Summary Add a checklist item
+// @Description Append an item to the checklist of a task
+// @Tags Checklists
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body checklist.CreateRequest true "Checklist item data"
+// @Success 201 {object} response.Response{data=aggregate.ItemResponse} "created"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/checklist [post]
+func (h *ChecklistHandler) Create(c *gin.Context) {
+	id := c.Param("id")
+
+	req, err := response.Parse[checklist.CreateRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	result, err := h.checklistService.Create(id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Created(c, result)
+}
+
+// Update godoc
+// @Summary Update a checklist item
+// @Description Update the text, done flag or assignee of a checklist item
+// @Tags Checklists
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param item_id path string true "Checklist item ID"
+// @Param request body checklist.UpdateRequest true "Checklist item data"
+// @Success 200 {object} response.Response{data=aggregate.ItemResponse} "Checklist item updated successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task or checklist item not found"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/checklist/{item_id} [put]
+func (h *ChecklistHandler) Update(c *gin.Context) {
+	id := c.Param("id")
+	itemID := c.Param("item_id")
+
+	req, err := response.Parse[checklist.UpdateRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	result, err := h.checklistService.Update(id, itemID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Checklist item updated successfully", result, nil)
+}
+
+// Delete godoc
+// @Summary Delete a checklist item
+// @Description Remove an item from the checklist of a task
+// @Tags Checklists
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param item_id path string true "Checklist item ID"
+// @Success 200 {object} response.Response "Checklist item deleted successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task or checklist item not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/checklist/{item_id} [delete]
+func (h *ChecklistHandler) Delete(c *gin.Context) {
+	id := c.Param("id")
+	itemID := c.Param("item_id")
+
+	err := h.checklistService.Delete(id, itemID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Checklist item deleted successfully", nil, nil)
+}
+
+// Reorder godoc
+// @Summary Reorder checklist items
+// @Description Set the order of the checklist items, every item of the task must be listed once
+// @Tags Checklists
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body checklist.ReorderRequest true "Ordered checklist item IDs"
+// @Success 200 {object} response.Response{data=[]aggregate.ItemResponse} "Checklist reordered successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/checklist/reorder [put]
+func (h *ChecklistHandler) Reorder(c *gin.Context) {
+	id := c.Param("id")
+
+	req, err := response.Parse[checklist.ReorderRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	result, err := h.checklistService.Reorder(id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Checklist reordered successfully", result, nil)
+}
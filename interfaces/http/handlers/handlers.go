@@ -1,21 +1,49 @@
 package handlers
 
 import (
+	"task_mng/services/attachment"
+	"task_mng/services/checklist"
+	"task_mng/services/project"
+	"task_mng/services/recurrence"
+	"task_mng/services/sprint"
 	"task_mng/services/task"
+	"task_mng/services/template"
 	"task_mng/services/user"
+	"task_mng/services/worklog"
 )
 
 type Handlers struct {
-	User *UserHandler
-	Task *TaskHandler
+	User       *UserHandler
+	Task       *TaskHandler
+	Recurrence *RecurrenceHandler
+	Template   *TemplateHandler
+	Checklist  *ChecklistHandler
+	Attachment *AttachmentHandler
+	Worklog    *WorklogHandler
+	Sprint     *SprintHandler
+	Project    *ProjectHandler
 }
 
 func New(
 	userService *user.Service,
 	taskService *task.Service,
+	recurrenceService *recurrence.Service,
+	templateService *template.Service,
+	checklistService *checklist.Service,
+	attachmentService *attachment.Service,
+	worklogService *worklog.Service,
+	sprintService *sprint.Service,
+	projectService *project.Service,
 ) *Handlers {
 	return &Handlers{
-		User: NewUserHandler(userService),
-		Task: NewTaskHandler(taskService),
+		User:       NewUserHandler(userService),
+		Task:       NewTaskHandler(taskService),
+		Recurrence: NewRecurrenceHandler(recurrenceService),
+		Template:   NewTemplateHandler(templateService),
+		Checklist:  NewChecklistHandler(checklistService),
+		Attachment: NewAttachmentHandler(attachmentService),
+		Worklog:    NewWorklogHandler(worklogService),
+		Sprint:     NewSprintHandler(sprintService),
+		Project:    NewProjectHandler(projectService),
 	}
 }
@@ -0,0 +1,251 @@
+package handlers
+
+import (
+	"task_mng/pkg/response"
+	"task_mng/services/project"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ProjectHandler struct {
+	projectService *project.Service
+}
+
+func NewProjectHandler(projectService *project.Service) *ProjectHandler {
+	return &ProjectHandler{projectService: projectService}
+}
+
+// Create godoc
+// @Summary Create a project
+// @Description Create a project that groups tasks and owns their custom fields
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param request body project.CreateRequest true "Project creation data"
+// @Success 201 {object} response.Response{data=aggregate.ProjectResponse} "created"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 409 {object} response.Response "Project name already exists"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /projects [post]
+func (h *ProjectHandler) Create(c *gin.Context) {
+	req, err := response.Parse[project.CreateRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	result, err := h.projectService.Create(req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Created(c, result)
+}
+
+// Update godoc
+// @Summary Update a project
+// @Description Update the name and description of a project
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param request body project.UpdateRequest true "Project update data"
+// @Success 200 {object} response.Response{data=aggregate.ProjectResponse} "Project updated successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Project not found"
+// @Failure 409 {object} response.Response "Project name already exists"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /projects/{id} [put]
+func (h *ProjectHandler) Update(c *gin.Context) {
+	id := c.Param("id")
+
+	req, err := response.Parse[project.UpdateRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	result, err := h.projectService.Update(req, id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Project updated successfully", result, nil)
+}
+
+// FindByID godoc
+// @Summary Get a project by ID
+// @Description Get detailed information about a specific project
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} response.Response{data=aggregate.ProjectResponse} "Project fetched successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Project not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /projects/{id} [get]
+func (h *ProjectHandler) FindByID(c *gin.Context) {
+	id := c.Param("id")
+
+	result, err := h.projectService.FindByID(id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Project fetched successfully", result, nil)
+}
+
+// FindAll godoc
+// @Summary Get all projects
+// @Description Get a list of projects with pagination ordered by name
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} response.Response{data=aggregate.ProjectListResponse} "Projects fetched successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /projects [get]
+func (h *ProjectHandler) FindAll(c *gin.Context) {
+	pag := response.NewPagination(c)
+
+	result, err := h.projectService.FindAll(pag.Page, pag.Limit)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Projects fetched successfully", result.Projects, result.Meta)
+}
+
+// FindFields godoc
+// @Summary Get the custom fields of a project
+// @Description Get the custom field definitions tasks of the project are validated against
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} response.Response{data=[]aggregate.FieldResponse} "Fields fetched successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Project not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /projects/{id}/fields [get]
+func (h *ProjectHandler) FindFields(c *gin.Context) {
+	id := c.Param("id")
+
+	result, err := h.projectService.FindFields(id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Fields fetched successfully", result, nil)
+}
+
+// CreateField godoc
+// @Summary Add a custom field to a project
+// @Description Define a typed custom field (text, number, date, select, multi_select, user), select fields need options
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param request body project.CreateFieldRequest true "Field definition"
+// @Success 201 {object} response.Response{data=aggregate.FieldResponse} "created"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Project not found"
+// @Failure 409 {object} response.Response "Field key already exists"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /projects/{id}/fields [post]
+func (h *ProjectHandler) CreateField(c *gin.Context) {
+	id := c.Param("id")
+
+	req, err := response.Parse[project.CreateFieldRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	result, err := h.projectService.CreateField(id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Created(c, result)
+}
+
+// UpdateField godoc
+// @Summary Update a custom field of a project
+// @Description Update the name, options and required flag of a custom field, the key and type can't be changed
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param field_id path string true "Field ID"
+// @Param request body project.UpdateFieldRequest true "Field update data"
+// @Success 200 {object} response.Response{data=aggregate.FieldResponse} "Field updated successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Project or field not found"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /projects/{id}/fields/{field_id} [put]
+func (h *ProjectHandler) UpdateField(c *gin.Context) {
+	id := c.Param("id")
+	fieldID := c.Param("field_id")
+
+	req, err := response.Parse[project.UpdateFieldRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	result, err := h.projectService.UpdateField(id, fieldID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Field updated successfully", result, nil)
+}
+
+// DeleteField godoc
+// @Summary Delete a custom field of a project
+// @Description Delete a custom field definition, values already stored on tasks are kept
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param field_id path string true "Field ID"
+// @Success 200 {object} response.Response "Field deleted successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Project or field not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /projects/{id}/fields/{field_id} [delete]
+func (h *ProjectHandler) DeleteField(c *gin.Context) {
+	id := c.Param("id")
+	fieldID := c.Param("field_id")
+
+	err := h.projectService.DeleteField(id, fieldID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Field deleted successfully", nil, nil)
+}
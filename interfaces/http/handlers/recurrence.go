@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"task_mng/pkg/response"
+	"task_mng/services/recurrence"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RecurrenceHandler struct {
+	recurrenceService *recurrence.Service
+}
+
+func NewRecurrenceHandler(recurrenceService *recurrence.Service) *RecurrenceHandler {
+	return &RecurrenceHandler{recurrenceService: recurrenceService}
+}
+
+// Create godoc
+// @Summary Create a recurring task
+// @Description Create a recurrence rule (daily, weekly or monthly) that periodically generates tasks
+// @Tags Recurrences
+// @Accept json
+// @Produce json
+// @Param request body recurrence.CreateRequest true "Recurrence creation data"
+// @Success 201 {object} response.Response{data=aggregate.RecurrenceResponse} "created"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /recurrences [post]
+func (h *RecurrenceHandler) Create(c *gin.Context) {
+	req, err := response.Parse[recurrence.CreateRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	result, err := h.recurrenceService.Create(req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Created(c, result)
+}
+
+// FindByID godoc
+// @Summary Get a recurring task by ID
+// @Description Get a recurrence rule and its next run
+// @Tags Recurrences
+// @Accept json
+// @Produce json
+// @Param id path string true "Recurrence ID"
+// @Success 200 {object} response.Response{data=aggregate.RecurrenceResponse} "Recurrence fetched successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Recurrence not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /recurrences/{id} [get]
+func (h *RecurrenceHandler) FindByID(c *gin.Context) {
+	id := c.Param("id")
+
+	result, err := h.recurrenceService.FindByID(id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Recurrence fetched successfully", result, nil)
+}
+
+// FindAll godoc
+// @Summary Get all recurring tasks
+// @Description Get a list of recurrence rules with pagination
+// @Tags Recurrences
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} response.Response{data=aggregate.RecurrenceListResponse} "Recurrences fetched successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /recurrences [get]
+func (h *RecurrenceHandler) FindAll(c *gin.Context) {
+	pag := response.NewPagination(c)
+
+	result, err := h.recurrenceService.FindAll(pag.Page, pag.Limit)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Recurrences fetched successfully", result.Recurrences, result.Meta)
+}
+
+// Delete godoc
+// @Summary Delete a recurring task
+// @Description Delete a recurrence rule, already generated tasks are kept
+// @Tags Recurrences
+// @Accept json
+// @Produce json
+// @Param id path string true "Recurrence ID"
+// @Success 200 {object} response.Response "Recurrence deleted successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Recurrence not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /recurrences/{id} [delete]
+func (h *RecurrenceHandler) Delete(c *gin.Context) {
+	id := c.Param("id")
+
+	err := h.recurrenceService.Delete(id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Recurrence deleted successfully", nil, nil)
+}
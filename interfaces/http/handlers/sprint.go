@@ -0,0 +1,307 @@
+package handlers
+
+import (
+	"task_mng/pkg/response"
+	"task_mng/services/sprint"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SprintHandler struct {
+	sprintService *sprint.Service
+}
+
+func NewSprintHandler(sprintService *sprint.Service) *SprintHandler {
+	return &SprintHandler{sprintService: sprintService}
+}
+
+// Create godoc
+// @Summary Create a sprint
+// @Description Plan a new sprint with a name, goal and date range
+// @Tags Sprints
+// @Accept json
+// @Produce json
+// @Param request body sprint.CreateRequest true "Sprint creation data"
+// @Success 201 {object} response.Response{data=aggregate.SprintResponse} "created"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /sprints [post]
+func (h *SprintHandler) Create(c *gin.Context) {
+	req, err := response.Parse[sprint.CreateRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	result, err := h.sprintService.Create(req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Created(c, result)
+}
+
+// Update godoc
+// @Summary Update a sprint
+// @Description Update the name, goal and dates of a sprint that is not completed
+// @Tags Sprints
+// @Accept json
+// @Produce json
+// @Param id path string true "Sprint ID"
+// @Param request body sprint.UpdateRequest true "Sprint update data"
+// @Success 200 {object} response.Response{data=aggregate.SprintResponse} "Sprint updated successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Sprint not found"
+// @Failure 409 {object} response.Response "Sprint already completed"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /sprints/{id} [put]
+func (h *SprintHandler) Update(c *gin.Context) {
+	id := c.Param("id")
+
+	req, err := response.Parse[sprint.UpdateRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	result, err := h.sprintService.Update(req, id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Sprint updated successfully", result, nil)
+}
+
+// FindByID godoc
+// @Summary Get a sprint by ID
+// @Description Get detailed information about a specific sprint
+// @Tags Sprints
+// @Accept json
+// @Produce json
+// @Param id path string true "Sprint ID"
+// @Success 200 {object} response.Response{data=aggregate.SprintResponse} "Sprint fetched successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Sprint not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /sprints/{id} [get]
+func (h *SprintHandler) FindByID(c *gin.Context) {
+	id := c.Param("id")
+
+	result, err := h.sprintService.FindByID(id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Sprint fetched successfully", result, nil)
+}
+
+// FindAll godoc
+// @Summary Get all sprints
+// @Description Get a list of sprints with pagination, latest first
+// @Tags Sprints
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} response.Response{data=aggregate.SprintListResponse} "Sprints fetched successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /sprints [get]
+func (h *SprintHandler) FindAll(c *gin.Context) {
+	pag := response.NewPagination(c)
+
+	result, err := h.sprintService.FindAll(pag.Page, pag.Limit)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Sprints fetched successfully", result.Sprints, result.Meta)
+}
+
+// FindTasks godoc
+// @Summary Get the tasks of a sprint
+// @Description Get the tasks currently assigned to a sprint
+// @Tags Sprints
+// @Accept json
+// @Produce json
+// @Param id path string true "Sprint ID"
+// @Success 200 {object} response.Response{data=[]aggregate.TaskResponse} "Sprint tasks fetched successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Sprint not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /sprints/{id}/tasks [get]
+func (h *SprintHandler) FindTasks(c *gin.Context) {
+	id := c.Param("id")
+
+	result, err := h.sprintService.FindTasks(id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Sprint tasks fetched successfully", result, nil)
+}
+
+// AddTasks godoc
+// @Summary Add tasks to a sprint
+// @Description Move tasks from the backlog or another sprint into the sprint
+// @Tags Sprints
+// @Accept json
+// @Produce json
+// @Param id path string true "Sprint ID"
+// @Param request body sprint.TasksRequest true "Task IDs"
+// @Success 200 {object} response.Response "Tasks added successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Sprint or task not found"
+// @Failure 409 {object} response.Response "Sprint already completed"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /sprints/{id}/tasks [post]
+func (h *SprintHandler) AddTasks(c *gin.Context) {
+	id := c.Param("id")
+
+	req, err := response.Parse[sprint.TasksRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	err = h.sprintService.AddTasks(id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Tasks added successfully", nil, nil)
+}
+
+// RemoveTasks godoc
+// @Summary Remove tasks from a sprint
+// @Description Move tasks of the sprint back to the backlog
+// @Tags Sprints
+// @Accept json
+// @Produce json
+// @Param id path string true "Sprint ID"
+// @Param request body sprint.TasksRequest true "Task IDs"
+// @Success 200 {object} response.Response "Tasks removed successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Sprint or task not found"
+// @Failure 409 {object} response.Response "Sprint already completed"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /sprints/{id}/tasks [delete]
+func (h *SprintHandler) RemoveTasks(c *gin.Context) {
+	id := c.Param("id")
+
+	req, err := response.Parse[sprint.TasksRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	err = h.sprintService.RemoveTasks(id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Tasks removed successfully", nil, nil)
+}
+
+// Start godoc
+// @Summary Start a sprint
+// @Description Activate a planned sprint, only one sprint can be active at a time
+// @Tags Sprints
+// @Accept json
+// @Produce json
+// @Param id path string true "Sprint ID"
+// @Success 200 {object} response.Response{data=aggregate.SprintResponse} "Sprint started successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Sprint not found"
+// @Failure 409 {object} response.Response "Sprint not planned or another sprint is active"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /sprints/{id}/start [post]
+func (h *SprintHandler) Start(c *gin.Context) {
+	id := c.Param("id")
+
+	result, err := h.sprintService.Start(id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Sprint started successfully", result, nil)
+}
+
+// Complete godoc
+// @Summary Complete a sprint
+// @Description Complete the active sprint, unfinished tasks move to the next sprint or back to the backlog
+// @Tags Sprints
+// @Accept json
+// @Produce json
+// @Param id path string true "Sprint ID"
+// @Param request body sprint.CompleteRequest true "Carry-over target"
+// @Success 200 {object} response.Response{data=aggregate.CompleteResponse} "Sprint completed successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Sprint not found"
+// @Failure 409 {object} response.Response "Sprint not active"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /sprints/{id}/complete [post]
+func (h *SprintHandler) Complete(c *gin.Context) {
+	id := c.Param("id")
+
+	req, err := response.Parse[sprint.CompleteRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	result, err := h.sprintService.Complete(id, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Sprint completed successfully", result, nil)
+}
+
+// Burndown godoc
+// @Summary Get sprint burndown data
+// @Description Get the remaining tasks and estimate at the end of each sprint day, computed from the task status history
+// @Tags Sprints
+// @Accept json
+// @Produce json
+// @Param id path string true "Sprint ID"
+// @Success 200 {object} response.Response{data=aggregate.BurndownResponse} "Burndown fetched successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Sprint not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /sprints/{id}/burndown [get]
+func (h *SprintHandler) Burndown(c *gin.Context) {
+	id := c.Param("id")
+
+	result, err := h.sprintService.Burndown(id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Burndown fetched successfully", result, nil)
+}
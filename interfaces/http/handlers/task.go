@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"encoding/json"
 	"task_mng/pkg/response"
 	"task_mng/services/task"
 
@@ -22,20 +23,26 @@ func NewTaskHandler(taskService *task.Service) *TaskHandler {
 // @Accept json
 // @Produce json
 // @Param request body task.CreateRequest true "Task creation data"
+// @Param Idempotency-Key header string false "Key to safely retry the request, retries with the same key replay the first response"
 // @Success 201 {object} response.Response "created"
 // @Failure 400 {object} response.Response "Bad request"
+// @Failure 409 {object} response.Response "WIP limit reached or the request with the same idempotency key is in progress"
+// @Failure 422 {object} response.Response "Validation failed or idempotency key reused with a different body"
+// @Failure 500 {object} response.Response "Internal server error"
 // @Security BearerAuth
 // @Router /tasks [post]
 func (h *TaskHandler) Create(c *gin.Context) {
+	userID, _ := c.Get("user_id")
+
 	req, err := response.Parse[task.CreateRequest](c)
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
 
-	err = h.taskService.Create(req)
+	err = h.taskService.Create(req, userID.(uint))
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
 
@@ -49,23 +56,84 @@ func (h *TaskHandler) Create(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Task ID"
+// @Param If-Match header string false "ETag of the task as last read, the update fails with 412 when the task changed since"
 // @Param request body task.UpdateRequest true "Task update data"
 // @Success 200 {object} response.Response "Task updated successfully"
 // @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 412 {object} response.Response "Task changed since it was read"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
 // @Security BearerAuth
 // @Router /tasks/{id} [put]
 func (h *TaskHandler) Update(c *gin.Context) {
 	id := c.Param("id")
+	userID, _ := c.Get("user_id")
 
 	req, err := response.Parse[task.UpdateRequest](c)
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
+		return
+	}
+
+	req.Version, err = response.IfMatch(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	err = h.taskService.Update(req, id, userID.(uint))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Task updated successfully", nil, nil)
+}
+
+// Patch godoc
+// @Summary Partially update a task
+// @Description Change only the fields given in a JSON merge patch (RFC 7396), null removes or resets a field.
+// @Description Patchable fields are summary, description, assignee, reporter, priority, due_date, labels, original_estimate, remaining_estimate and custom_fields.
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param If-Match header string false "ETag of the task as last read, the update fails with 412 when the task changed since"
+// @Param request body object true "JSON merge patch of the task"
+// @Success 200 {object} response.Response "Task updated successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 412 {object} response.Response "Task changed since it was read"
+// @Failure 415 {object} response.Response "Unsupported patch format"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/{id} [patch]
+func (h *TaskHandler) Patch(c *gin.Context) {
+	id := c.Param("id")
+	userID, _ := c.Get("user_id")
+
+	if contentType := c.ContentType(); contentType != "application/merge-patch+json" && contentType != "application/json" {
+		response.UnsupportedMediaType(c, "unsupported_patch_format")
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := c.ShouldBindJSON(&patch); err != nil {
+		response.BadRequest(c, "invalid_merge_patch")
+		return
+	}
+
+	version, err := response.IfMatch(c)
+	if err != nil {
+		c.Error(err)
 		return
 	}
 
-	err = h.taskService.Update(req, id)
+	err = h.taskService.Patch(id, &task.PatchRequest{Patch: patch, Version: version}, userID.(uint))
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
 
@@ -80,7 +148,10 @@ func (h *TaskHandler) Update(c *gin.Context) {
 // @Produce json
 // @Param id path string true "Task ID"
 // @Success 200 {object} response.Response{data=aggregate.TaskResponse} "Task fetched successfully"
+// @Header 200 {string} ETag "Version of the task, send it back in If-Match to update it"
 // @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 500 {object} response.Response "Internal server error"
 // @Security BearerAuth
 // @Router /tasks/{id} [get]
 func (h *TaskHandler) FindByID(c *gin.Context) {
@@ -88,9 +159,10 @@ func (h *TaskHandler) FindByID(c *gin.Context) {
 
 	task, err := h.taskService.FindByID(id)
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
+	c.Header("ETag", response.ETag(task.Version))
 	response.Success(c, "Task fetched successfully", task, nil)
 }
 
@@ -103,10 +175,16 @@ func (h *TaskHandler) FindByID(c *gin.Context) {
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(10)
 // @Param assignee query string false "Filter by assignee username"
+// @Param reporter query string false "Filter by reporter username"
+// @Param project query int false "Filter by project ID"
+// @Param cf[key] query string false "Filter by custom field value, e.g. cf[customer]=ACME"
 // @Param status query string false "Filter by status (ToDo, InProgress, Done)" Enums(ToDo, InProgress, Done)
 // @Param priority query string false "Filter by priority (lowest, low, medium, high, highest)" Enums(lowest, low, medium, high, highest)
+// @Param include_archived query bool false "Include archived tasks" default(false)
 // @Success 200 {object} response.Response{data=aggregate.TaskListResponse} "Tasks fetched successfully"
 // @Failure 400 {object} response.Response "Bad request"
+// @Failure 422 {object} response.Response "Unknown reporter"
+// @Failure 500 {object} response.Response "Internal server error"
 // @Security BearerAuth
 // @Router /tasks [get]
 func (h *TaskHandler) FindAll(c *gin.Context) {
@@ -114,13 +192,14 @@ func (h *TaskHandler) FindAll(c *gin.Context) {
 
 	req, err := response.ParseQuery[task.FilterRequest](c)
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
+	req.CustomFields = c.QueryMap("cf")
 
 	result, err := h.taskService.FindAll(req, pag.Page, pag.Limit)
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
 
@@ -129,13 +208,15 @@ func (h *TaskHandler) FindAll(c *gin.Context) {
 
 // Delete godoc
 // @Summary Delete a task
-// @Description Delete a task by ID
+// @Description Move a task to the trash, it can be restored until the trash retention purges it
 // @Tags Tasks
 // @Accept json
 // @Produce json
 // @Param id path string true "Task ID"
 // @Success 200 {object} response.Response "Task deleted successfully"
 // @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 500 {object} response.Response "Internal server error"
 // @Security BearerAuth
 // @Router /tasks/{id} [delete]
 func (h *TaskHandler) Delete(c *gin.Context) {
@@ -143,13 +224,145 @@ func (h *TaskHandler) Delete(c *gin.Context) {
 
 	err := h.taskService.Delete(id)
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
 
 	response.Success(c, "Task deleted successfully", nil, nil)
 }
 
+// Archive godoc
+// @Summary Archive a task
+// @Description Hide a task from listings, the board and the status counts without deleting it
+// @Tags Archive
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} response.Response "Task archived successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 409 {object} response.Response "Task already archived"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/archive [post]
+func (h *TaskHandler) Archive(c *gin.Context) {
+	id := c.Param("id")
+	userID, _ := c.Get("user_id")
+
+	err := h.taskService.Archive(id, userID.(uint))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Task archived successfully", nil, nil)
+}
+
+// Unarchive godoc
+// @Summary Unarchive a task
+// @Description Bring an archived task back to listings and the board
+// @Tags Archive
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} response.Response "Task unarchived successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 409 {object} response.Response "Task not archived or WIP limit reached"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/unarchive [post]
+func (h *TaskHandler) Unarchive(c *gin.Context) {
+	id := c.Param("id")
+	userID, _ := c.Get("user_id")
+
+	err := h.taskService.Unarchive(id, userID.(uint))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Task unarchived successfully", nil, nil)
+}
+
+// Trash godoc
+// @Summary Get deleted tasks
+// @Description Get the tasks in the trash, most recently deleted first
+// @Tags Trash
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} response.Response{data=[]aggregate.TaskResponse} "Deleted tasks fetched successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/trash [get]
+func (h *TaskHandler) Trash(c *gin.Context) {
+	pag := response.NewPagination(c)
+
+	result, err := h.taskService.Trash(pag.Page, pag.Limit)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Deleted tasks fetched successfully", result.Tasks, result.Meta)
+}
+
+// Restore godoc
+// @Summary Restore a deleted task
+// @Description Bring a task back from the trash
+// @Tags Trash
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} response.Response "Task restored successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 409 {object} response.Response "Task not in trash or WIP limit reached"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/restore [post]
+func (h *TaskHandler) Restore(c *gin.Context) {
+	id := c.Param("id")
+	userID, _ := c.Get("user_id")
+
+	err := h.taskService.Restore(id, userID.(uint))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Task restored successfully", nil, nil)
+}
+
+// Purge godoc
+// @Summary Permanently delete a task
+// @Description Delete a task and its history, watchers and mentions for good, admins only
+// @Tags Trash
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} response.Response "Task permanently deleted successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 403 {object} response.Response "Admin required"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/permanent [delete]
+func (h *TaskHandler) Purge(c *gin.Context) {
+	id := c.Param("id")
+
+	err := h.taskService.Purge(id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Task permanently deleted successfully", nil, nil)
+}
+
 // Assign godoc
 // @Summary Assign a task to a user
 // @Description Assign or reassign a task to a specific user
@@ -159,18 +372,23 @@ func (h *TaskHandler) Delete(c *gin.Context) {
 // @Param request body task.AssignRequest true "Task assignment data"
 // @Success 200 {object} response.Response "Task assigned successfully"
 // @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
 // @Security BearerAuth
 // @Router /tasks/assign [put]
 func (h *TaskHandler) Assign(c *gin.Context) {
+	userID, _ := c.Get("user_id")
+
 	req, err := response.Parse[task.AssignRequest](c)
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
 
-	err = h.taskService.Assign(req)
+	err = h.taskService.Assign(req, userID.(uint))
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
 
@@ -186,20 +404,244 @@ func (h *TaskHandler) Assign(c *gin.Context) {
 // @Param request body task.StatusTransitionRequest true "Task status transition data"
 // @Success 200 {object} response.Response "Task status transitioned successfully"
 // @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 409 {object} response.Response "WIP limit reached"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
 // @Security BearerAuth
 // @Router /tasks/transition [put]
 func (h *TaskHandler) Transition(c *gin.Context) {
+	userID, _ := c.Get("user_id")
+
 	req, err := response.Parse[task.StatusTransitionRequest](c)
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
 
-	err = h.taskService.StatusTransition(req)
+	err = h.taskService.StatusTransition(req, userID.(uint))
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
 
 	response.Success(c, "Task status transitioned successfully", nil, nil)
 }
+
+// Board godoc
+// @Summary Get the kanban board
+// @Description Get the tasks grouped by status column in rank order together with the column WIP limits
+// @Tags Board
+// @Accept json
+// @Produce json
+// @Param assignee query string false "Filter by assignee username"
+// @Param sprint query int false "Filter by sprint ID"
+// @Success 200 {object} response.Response{data=aggregate.BoardResponse} "Board fetched successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/board [get]
+func (h *TaskHandler) Board(c *gin.Context) {
+	req, err := response.ParseQuery[task.BoardRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	board, err := h.taskService.Board(req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Board fetched successfully", board, nil)
+}
+
+// Columns godoc
+// @Summary Get board column settings
+// @Description Get the WIP limit of every board column, 0 means no limit
+// @Tags Board
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Response{data=[]aggregate.ColumnResponse} "Columns fetched successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/board/columns [get]
+func (h *TaskHandler) Columns(c *gin.Context) {
+	columns, err := h.taskService.Columns()
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Columns fetched successfully", columns, nil)
+}
+
+// UpdateColumn godoc
+// @Summary Update board column settings
+// @Description Set the WIP limit of a board column, 0 removes the limit, only admins can change it
+// @Tags Board
+// @Accept json
+// @Produce json
+// @Param status path string true "Column status" Enums(ToDo, InProgress, Done)
+// @Param request body task.UpdateColumnRequest true "Column settings"
+// @Success 200 {object} response.Response "Column updated successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 403 {object} response.Response "Admin required"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/board/columns/{status} [put]
+func (h *TaskHandler) UpdateColumn(c *gin.Context) {
+	req, err := response.Parse[task.UpdateColumnRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	err = h.taskService.UpdateColumn(c.Param("status"), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Column updated successfully", nil, nil)
+}
+
+// Move godoc
+// @Summary Move a task on the board
+// @Description Move a task to a position of a status column, the task goes to the end of the column when no position is given
+// @Tags Board
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body task.MoveRequest true "Target column and position"
+// @Success 200 {object} response.Response "Task moved successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 409 {object} response.Response "WIP limit reached"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/move [put]
+func (h *TaskHandler) Move(c *gin.Context) {
+	userID, _ := c.Get("user_id")
+
+	req, err := response.Parse[task.MoveRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	err = h.taskService.Move(c.Param("id"), req, userID.(uint))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Task moved successfully", nil, nil)
+}
+
+// Watchers godoc
+// @Summary Get task watchers
+// @Description Get the users that follow the updates of a task
+// @Tags Watchers
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} response.Response{data=[]aggregate.WatcherResponse} "Watchers fetched successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/watchers [get]
+func (h *TaskHandler) Watchers(c *gin.Context) {
+	id := c.Param("id")
+
+	watchers, err := h.taskService.Watchers(id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Watchers fetched successfully", watchers, nil)
+}
+
+// Watch godoc
+// @Summary Watch a task
+// @Description Follow the updates of a task as the current user
+// @Tags Watchers
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} response.Response "Task watched successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/watch [post]
+func (h *TaskHandler) Watch(c *gin.Context) {
+	id := c.Param("id")
+	userID, _ := c.Get("user_id")
+
+	err := h.taskService.Watch(id, userID.(uint))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Task watched successfully", nil, nil)
+}
+
+// Unwatch godoc
+// @Summary Unwatch a task
+// @Description Stop following the updates of a task as the current user
+// @Tags Watchers
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} response.Response "Task unwatched successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/watch [delete]
+func (h *TaskHandler) Unwatch(c *gin.Context) {
+	id := c.Param("id")
+	userID, _ := c.Get("user_id")
+
+	err := h.taskService.Unwatch(id, userID.(uint))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Task unwatched successfully", nil, nil)
+}
+
+// Mentions godoc
+// @Summary Get my mentions
+// @Description Get the tasks the current user was mentioned in with @username, newest first
+// @Tags Mentions
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} response.Response{data=[]aggregate.MentionResponse} "Mentions fetched successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /me/mentions [get]
+func (h *TaskHandler) Mentions(c *gin.Context) {
+	pag := response.NewPagination(c)
+	userID, _ := c.Get("user_id")
+
+	result, err := h.taskService.Mentions(userID.(uint), pag.Page, pag.Limit)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Mentions fetched successfully", result.Mentions, result.Meta)
+}
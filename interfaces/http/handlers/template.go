@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"task_mng/pkg/response"
+	"task_mng/services/template"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TemplateHandler struct {
+	templateService *template.Service
+}
+
+func NewTemplateHandler(templateService *template.Service) *TemplateHandler {
+	return &TemplateHandler{templateService: templateService}
+}
+
+// Create godoc
+// @Summary Create a task template
+// @Description Create a template with default summary, description, priority, labels and checklist
+// @Tags Templates
+// @Accept json
+// @Produce json
+// @Param request body template.CreateRequest true "Template creation data"
+// @Success 201 {object} response.Response{data=aggregate.TemplateResponse} "created"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 409 {object} response.Response "Template already exists"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /templates [post]
+func (h *TemplateHandler) Create(c *gin.Context) {
+	req, err := response.Parse[template.CreateRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	result, err := h.templateService.Create(req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Created(c, result)
+}
+
+// Update godoc
+// @Summary Update a task template
+// @Description Update an existing template by ID
+// @Tags Templates
+// @Accept json
+// @Produce json
+// @Param id path string true "Template ID"
+// @Param request body template.UpdateRequest true "Template update data"
+// @Success 200 {object} response.Response{data=aggregate.TemplateResponse} "Template updated successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Template not found"
+// @Failure 409 {object} response.Response "Template already exists"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /templates/{id} [put]
+func (h *TemplateHandler) Update(c *gin.Context) {
+	id := c.Param("id")
+
+	req, err := response.Parse[template.UpdateRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	result, err := h.templateService.Update(req, id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Template updated successfully", result, nil)
+}
+
+// FindByID godoc
+// @Summary Get a task template by ID
+// @Description Get detailed information about a specific template
+// @Tags Templates
+// @Accept json
+// @Produce json
+// @Param id path string true "Template ID"
+// @Success 200 {object} response.Response{data=aggregate.TemplateResponse} "Template fetched successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Template not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /templates/{id} [get]
+func (h *TemplateHandler) FindByID(c *gin.Context) {
+	id := c.Param("id")
+
+	result, err := h.templateService.FindByID(id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Template fetched successfully", result, nil)
+}
+
+// FindAll godoc
+// @Summary Get all task templates
+// @Description Get a list of templates with pagination
+// @Tags Templates
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} response.Response{data=aggregate.TemplateListResponse} "Templates fetched successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /templates [get]
+func (h *TemplateHandler) FindAll(c *gin.Context) {
+	pag := response.NewPagination(c)
+
+	result, err := h.templateService.FindAll(pag.Page, pag.Limit)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Templates fetched successfully", result.Templates, result.Meta)
+}
+
+// Delete godoc
+// @Summary Delete a task template
+// @Description Delete a template by ID
+// @Tags Templates
+// @Accept json
+// @Produce json
+// @Param id path string true "Template ID"
+// @Success 200 {object} response.Response "Template deleted successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Template not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /templates/{id} [delete]
+func (h *TemplateHandler) Delete(c *gin.Context) {
+	id := c.Param("id")
+
+	err := h.templateService.Delete(id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Template deleted successfully", nil, nil)
+}
+
+// CreateTask godoc
+// @Summary Create a task from a template
+// @Description Create a new task from a template, replacing {{name}} placeholders with the given variables
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Template ID"
+// @Param request body template.CreateTaskRequest true "Task data and template variables"
+// @Success 201 {object} response.Response "created"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Template not found"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/from-template/{id} [post]
+func (h *TemplateHandler) CreateTask(c *gin.Context) {
+	id := c.Param("id")
+	userID, _ := c.Get("user_id")
+
+	req, err := response.Parse[template.CreateTaskRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	err = h.templateService.CreateTask(id, req, userID.(uint))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Created(c, nil)
+}
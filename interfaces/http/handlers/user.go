@@ -33,18 +33,21 @@ type CreateRequest struct {
 // @Param request body user.CreateRequest true "User registration data"
 // @Success 200 {object} response.Response "Create successful"
 // @Failure 400 {object} response.Response "Bad request"
+// @Failure 409 {object} response.Response "User or email already exists"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
 // @Security BearerAuth
 // @Router /users [post]
 func (h *UserHandler) Create(c *gin.Context) {
 	req, err := response.Parse[user.CreateRequest](c)
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
 
 	err = h.userService.Create(req)
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
 
@@ -65,17 +68,22 @@ type LoginRequest struct {
 // @Param request body user.LoginRequest true "User login credentials"
 // @Success 200 {object} response.Response{data=aggregate.AuthResponse} "Login successful"
 // @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Username or password is incorrect"
+// @Failure 423 {object} response.Response "Account locked after too many failed logins"
+// @Failure 429 {object} response.Response "Too many failed logins from this address"
+// @Failure 500 {object} response.Response "Internal server error"
 // @Router /auth/login [post]
 func (h *UserHandler) Login(c *gin.Context) {
 	req, err := response.Parse[user.LoginRequest](c)
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
+	req.IP = c.ClientIP()
 
 	resp, err := h.userService.Login(req)
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
 
@@ -91,17 +99,20 @@ func (h *UserHandler) Login(c *gin.Context) {
 // @Param request body user.RefreshRequest true "Refresh token"
 // @Success 200 {object} response.Response{data=aggregate.AuthResponse} "Tokens refreshed successful"
 // @Failure 400 {object} response.Response "Bad request"
+// @Failure 401 {object} response.Response "Invalid or expired refresh token"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Failure 503 {object} response.Response "Session revocations can't be checked"
 // @Router /auth/refresh [post]
 func (h *UserHandler) Refresh(c *gin.Context) {
 	req, err := response.Parse[user.RefreshRequest](c)
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
 
 	resp, err := h.userService.Refresh(req)
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
 
@@ -116,13 +127,15 @@ func (h *UserHandler) Refresh(c *gin.Context) {
 // @Produce json
 // @Success 200 {object} response.Response{data=aggregate.UserResponse} "User fetched"
 // @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "User not found"
+// @Failure 500 {object} response.Response "Internal server error"
 // @Security BearerAuth
 // @Router /profile [get]
 func (h *UserHandler) Me(c *gin.Context) {
 	userID, _ := c.Get("user_id")
 	user, err := h.userService.FindByID(userID.(uint))
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
 	response.Success(c, "User fetched", user, nil)
@@ -130,13 +143,18 @@ func (h *UserHandler) Me(c *gin.Context) {
 
 // Update godoc
 // @Summary Update user profile
-// @Description Update the authenticated user's profile information
+// @Description Update the authenticated user's profile information, a new email has to be verified again
 // @Tags Profile
 // @Accept json
 // @Produce json
 // @Param request body user.UpdateProfileRequest true "Profile update data"
 // @Success 200 {object} response.Response{data=aggregate.UserResponse} "Profile updated successfully"
 // @Failure 400 {object} response.Response "Bad request"
+// @Failure 403 {object} response.Response "The request was made with an access token"
+// @Failure 404 {object} response.Response "User not found"
+// @Failure 409 {object} response.Response "Email already in use"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
 // @Security BearerAuth
 // @Router /profile [put]
 func (h *UserHandler) Update(c *gin.Context) {
@@ -144,19 +162,238 @@ func (h *UserHandler) Update(c *gin.Context) {
 
 	req, err := response.Parse[user.UpdateProfileRequest](c)
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
 
 	resp, err := h.userService.UpdateProfile(userID.(uint), req)
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
 
 	response.Success(c, "Profile updated successfully", resp, nil)
 }
 
+// ChangePassword godoc
+// @Summary Change password
+// @Description Change the authenticated user's password, every other session is signed out
+// @Tags Profile
+// @Accept json
+// @Produce json
+// @Param request body user.ChangePasswordRequest true "Current and new password"
+// @Success 200 {object} response.Response "Password changed successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 403 {object} response.Response "The request was made with an access token"
+// @Failure 404 {object} response.Response "User not found"
+// @Failure 422 {object} response.Response "Current password is incorrect"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /profile/password [put]
+func (h *UserHandler) ChangePassword(c *gin.Context) {
+	userID, _ := c.Get("user_id")
+
+	req, err := response.Parse[user.ChangePasswordRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	err = h.userService.ChangePassword(userID.(uint), c.GetString("session_id"), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Password changed successfully", nil, nil)
+}
+
+// ForgotPassword godoc
+// @Summary Request a password reset
+// @Description Send a single use password reset token to the user with the email, it answers the same for unknown emails
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body user.ForgotPasswordRequest true "Email of the account"
+// @Success 200 {object} response.Response "Password reset requested"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /auth/password/forgot [post]
+func (h *UserHandler) ForgotPassword(c *gin.Context) {
+	req, err := response.Parse[user.ForgotPasswordRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	err = h.userService.ForgotPassword(req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Password reset requested", nil, nil)
+}
+
+// ResetPassword godoc
+// @Summary Reset password
+// @Description Set a new password with a reset token, every session of the user is signed out
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body user.ResetPasswordRequest true "Reset token and new password"
+// @Success 200 {object} response.Response "Password reset successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 422 {object} response.Response "Invalid or expired reset token"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /auth/password/reset [post]
+func (h *UserHandler) ResetPassword(c *gin.Context) {
+	req, err := response.Parse[user.ResetPasswordRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	err = h.userService.ResetPassword(req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Password reset successfully", nil, nil)
+}
+
+// VerifyEmail godoc
+// @Summary Verify email
+// @Description Verify the email of a user with the token sent to it
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body user.VerifyEmailRequest true "Verification token"
+// @Success 200 {object} response.Response "Email verified successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 422 {object} response.Response "Invalid or expired verification token"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /auth/email/verify [post]
+func (h *UserHandler) VerifyEmail(c *gin.Context) {
+	req, err := response.Parse[user.VerifyEmailRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	err = h.userService.VerifyEmail(req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Email verified successfully", nil, nil)
+}
+
+// ResendVerification godoc
+// @Summary Resend email verification
+// @Description Send a new verification token to the authenticated user's email
+// @Tags Profile
+// @Produce json
+// @Success 200 {object} response.Response "Verification sent"
+// @Failure 404 {object} response.Response "User not found"
+// @Failure 409 {object} response.Response "Email already verified"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /profile/email/verification [post]
+func (h *UserHandler) ResendVerification(c *gin.Context) {
+	userID, _ := c.Get("user_id")
+
+	err := h.userService.ResendVerification(userID.(uint))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Verification sent", nil, nil)
+}
+
+// CreateAccessToken godoc
+// @Summary Create a personal access token
+// @Description Create a personal access token for automation, the token is only returned once, retries with the same Idempotency-Key are rejected rather than replayed. Scopes are read, write and admin. Access tokens can't create access tokens
+// @Tags Profile
+// @Accept json
+// @Produce json
+// @Param request body user.CreateAccessTokenRequest true "Name, scopes and expiry of the token"
+// @Success 200 {object} response.Response{data=aggregate.CreatedAccessTokenResponse} "Access token created successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 403 {object} response.Response "Admin scope requires an admin or the request was made with an access token"
+// @Failure 409 {object} response.Response "Retried with an idempotency key, the token is not sent again"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /profile/tokens [post]
+func (h *UserHandler) CreateAccessToken(c *gin.Context) {
+	userID, _ := c.Get("user_id")
+
+	req, err := response.Parse[user.CreateAccessTokenRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	resp, err := h.userService.CreateAccessToken(userID.(uint), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Access token created successfully", resp, nil)
+}
+
+// AccessTokens godoc
+// @Summary List personal access tokens
+// @Description Get the authenticated user's personal access tokens that aren't revoked
+// @Tags Profile
+// @Produce json
+// @Success 200 {object} response.Response{data=[]aggregate.AccessTokenResponse} "Access tokens fetched successfully"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /profile/tokens [get]
+func (h *UserHandler) AccessTokens(c *gin.Context) {
+	userID, _ := c.Get("user_id")
+
+	resp, err := h.userService.FindAccessTokens(userID.(uint))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Access tokens fetched successfully", resp, nil)
+}
+
+// RevokeAccessToken godoc
+// @Summary Revoke a personal access token
+// @Description Revoke one of the authenticated user's personal access tokens
+// @Tags Profile
+// @Produce json
+// @Param id path string true "Access token ID"
+// @Success 200 {object} response.Response "Access token revoked successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Access token not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /profile/tokens/{id} [delete]
+func (h *UserHandler) RevokeAccessToken(c *gin.Context) {
+	id := c.Param("id")
+	userID, _ := c.Get("user_id")
+
+	err := h.userService.RevokeAccessToken(userID.(uint), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Access token revoked successfully", nil, nil)
+}
+
 // FindAll godoc
 // @Summary Get all users
 // @Description Get a list of all users with pagination
@@ -167,6 +404,7 @@ func (h *UserHandler) Update(c *gin.Context) {
 // @Param limit query int false "Items per page" default(10)
 // @Success 200 {object} response.Response{data=aggregate.UserListResponse} "Users fetched successfully"
 // @Failure 400 {object} response.Response "Bad request"
+// @Failure 500 {object} response.Response "Internal server error"
 // @Security BearerAuth
 // @Router /users [get]
 func (h *UserHandler) FindAll(c *gin.Context) {
@@ -174,8 +412,35 @@ func (h *UserHandler) FindAll(c *gin.Context) {
 
 	result, err := h.userService.FindAll(pag.Page, pag.Limit)
 	if err != nil {
-		response.BadRequest(c, err.Error())
+		c.Error(err)
 		return
 	}
 	response.Success(c, "Users fetched successfully", result, result.Meta)
 }
+
+// Unlock godoc
+// @Summary Unlock a user
+// @Description Lift the lockout of a user after too many failed logins, admins only
+// @Tags Users
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} response.Response "User unlocked successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 403 {object} response.Response "Admin required"
+// @Failure 404 {object} response.Response "User not found"
+// @Failure 409 {object} response.Response "User not locked"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /users/{id}/unlock [post]
+func (h *UserHandler) Unlock(c *gin.Context) {
+	id := c.Param("id")
+	userID, _ := c.Get("user_id")
+
+	err := h.userService.Unlock(id, userID.(uint))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "User unlocked successfully", nil, nil)
+}
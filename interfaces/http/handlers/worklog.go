@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"task_mng/pkg/response"
+	"task_mng/services/worklog"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WorklogHandler struct {
+	worklogService *worklog.Service
+}
+
+func NewWorklogHandler(worklogService *worklog.Service) *WorklogHandler {
+	return &WorklogHandler{worklogService: worklogService}
+}
+
+// Create godoc
+// @Summary Log work on a task
+// @Description Log time spent on a task by the authenticated user, the remaining estimate is reduced accordingly
+// @Tags Worklogs
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body worklog.CreateRequest true "Work log data"
+// @Success 201 {object} response.Response{data=aggregate.WorklogResponse} "created"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/worklogs [post]
+func (h *WorklogHandler) Create(c *gin.Context) {
+	id := c.Param("id")
+	userID, _ := c.Get("user_id")
+
+	req, err := response.Parse[worklog.CreateRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	result, err := h.worklogService.Create(id, userID.(uint), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Created(c, result)
+}
+
+// FindAll godoc
+// @Summary List work logs of a task
+// @Description Get the work logs of a task ordered by date
+// @Tags Worklogs
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} response.Response{data=[]aggregate.WorklogResponse} "Worklogs retrieved successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 404 {object} response.Response "Task not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/worklogs [get]
+func (h *WorklogHandler) FindAll(c *gin.Context) {
+	id := c.Param("id")
+
+	result, err := h.worklogService.FindAll(id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Worklogs retrieved successfully", result, nil)
+}
+
+// Delete godoc
+// @Summary Delete a work log
+// @Description Delete a work log of the authenticated user
+// @Tags Worklogs
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param worklog_id path string true "Work log ID"
+// @Success 200 {object} response.Response "Worklog deleted successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 403 {object} response.Response "Work log belongs to another user"
+// @Failure 404 {object} response.Response "Task or work log not found"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/worklogs/{worklog_id} [delete]
+func (h *WorklogHandler) Delete(c *gin.Context) {
+	id := c.Param("id")
+	worklogID := c.Param("worklog_id")
+	userID, _ := c.Get("user_id")
+
+	err := h.worklogService.Delete(id, worklogID, userID.(uint))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Worklog deleted successfully", nil, nil)
+}
+
+// Timesheet godoc
+// @Summary Get a timesheet report
+// @Description Get the logged time per user, day and task for a period, defaults to the current week
+// @Tags Worklogs
+// @Accept json
+// @Produce json
+// @Param user query string false "Filter by username"
+// @Param from query string false "First day of the period (YYYY-MM-DD)"
+// @Param to query string false "Last day of the period (YYYY-MM-DD)"
+// @Success 200 {object} response.Response{data=aggregate.TimesheetResponse} "Timesheet retrieved successfully"
+// @Failure 400 {object} response.Response "Bad request"
+// @Failure 422 {object} response.Response "Validation failed"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Security BearerAuth
+// @Router /worklogs/timesheet [get]
+func (h *WorklogHandler) Timesheet(c *gin.Context) {
+	req, err := response.ParseQuery[worklog.TimesheetRequest](c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	result, err := h.worklogService.Timesheet(req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response.Success(c, "Timesheet retrieved successfully", result, nil)
+}
@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"task_mng/pkg/apperror"
+	"task_mng/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ErrorHandler renders the last error a handler attached with c.Error,
+// typed errors keep their status and code, anything else becomes a 500 without leaking its message
+func ErrorHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+
+		err := apperror.From(c.Errors.Last().Err)
+		if err.Status >= http.StatusInternalServerError {
+			slog.Error("request failed", "method", c.Request.Method, "path", c.FullPath(), "error", err.Unwrap())
+		}
+
+		response.Error(c, err.Status, err.Code, err.Message, err.Details)
+	}
+}
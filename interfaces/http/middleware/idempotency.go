@@ -0,0 +1,216 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"log/slog"
+	"net/http"
+	"task_mng/pkg/redis"
+	"task_mng/pkg/response"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+const (
+	// IdempotencyKeyHeader is the header clients send to make a POST request safe to retry
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on responses that were replayed from an earlier request
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+	// idempotencyWithheldKey is set on requests whose response can't be stored, see WithholdIdempotentResponse
+	idempotencyWithheldKey = "idempotency_withheld"
+	// maxUnreadBody is how much of the body a handler may leave unread, it's read afterwards to complete the fingerprint
+	maxUnreadBody = 1 << 20 // 1MB
+)
+
+// idempotencyRecord is what is stored for an idempotency key, a record without status is still in progress
+type idempotencyRecord struct {
+	Fingerprint string `json:"fingerprint,omitempty"`
+	BodySize    int64  `json:"body_size,omitempty"`
+	Status      int    `json:"status,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+	// Withheld records that the request was handled without keeping its response
+	Withheld bool `json:"withheld,omitempty"`
+}
+
+// Idempotency stores the first response of a POST request sent with an Idempotency-Key header and replays
+// it when the request is retried with the same key, it has to run after LoginRequired as keys are per user
+// The body is hashed while the handler reads it, so it's never buffered and the handler's size limits still apply
+func Idempotency(redis redis.RedisClient, ttl time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if c.Request.Method != http.MethodPost || key == "" {
+			c.Next()
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			response.BadRequest(c, "invalid_idempotency_key")
+			c.Abort()
+			return
+		}
+
+		ctx := c.Request.Context()
+		userID, _ := c.Get("user_id")
+		redisKey := fmt.Sprintf("idempotency:%v:%s", userID, key)
+
+		// Claim the key, only the first request gets to run the handler
+		pending, _ := json.Marshal(idempotencyRecord{})
+		claimed, err := redis.SetNX(ctx, redisKey, pending, ttl)
+		if err != nil {
+			slog.Error("Failed to claim idempotency key, handling request without it", "error", err)
+			c.Next()
+			return
+		}
+
+		if !claimed {
+			replayIdempotent(c, redis, redisKey)
+			return
+		}
+
+		body := newHashingReader(c)
+		c.Request.Body = body
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+
+		c.Next()
+
+		// Only successful responses are stored, failed requests are released so the client can retry them.
+		// Errors passed to c.Error are rendered after this middleware returns, so nothing is written for them yet
+		if !recorder.Written() || recorder.Status() >= http.StatusBadRequest || !body.drain() {
+			if err := redis.Del(context.Background(), redisKey); err != nil {
+				slog.Error("Failed to release idempotency key", "error", err)
+			}
+			return
+		}
+
+		record := idempotencyRecord{
+			Fingerprint: body.fingerprint(),
+			BodySize:    body.size,
+			Status:      recorder.Status(),
+		}
+		if c.GetBool(idempotencyWithheldKey) {
+			record.Withheld = true
+		} else {
+			record.ContentType = recorder.Header().Get("Content-Type")
+			record.Body = recorder.body.Bytes()
+		}
+
+		value, _ := json.Marshal(record)
+		if err := redis.Set(context.Background(), redisKey, value, ttl); err != nil {
+			slog.Error("Failed to store idempotent response", "error", err)
+		}
+	}
+}
+
+// WithholdIdempotentResponse keeps the response of a route out of the idempotency store, for responses that
+// carry secrets. A retry with the same key still doesn't run the handler again, it's rejected instead
+func WithholdIdempotentResponse() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(idempotencyWithheldKey, true)
+		c.Next()
+	}
+}
+
+// replayIdempotent answers a retried request from the stored record of its key
+func replayIdempotent(c *gin.Context, redis redis.RedisClient, redisKey string) {
+	defer c.Abort()
+
+	value, err := redis.Get(c.Request.Context(), redisKey)
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			// The first request failed and released the key in the meantime
+			response.Conflict(c, "idempotency_key_in_progress")
+			return
+		}
+		slog.Error("Failed to get idempotent response", "error", err)
+		response.InternalServerError(c, "internal_server_error")
+		return
+	}
+
+	var record idempotencyRecord
+	if err := json.Unmarshal([]byte(value), &record); err != nil {
+		slog.Error("Failed to decode idempotent response", "error", err)
+		response.InternalServerError(c, "internal_server_error")
+		return
+	}
+
+	if record.Status == 0 {
+		response.Conflict(c, "idempotency_key_in_progress")
+		return
+	}
+
+	// A body longer than the stored one is a different request, there is no need to read all of it
+	body := newHashingReader(c)
+	_, err = io.Copy(io.Discard, io.LimitReader(body, record.BodySize+1))
+	if err != nil || body.size != record.BodySize || body.fingerprint() != record.Fingerprint {
+		response.UnprocessableEntity(c, "idempotency_key_reused")
+		return
+	}
+
+	if record.Withheld {
+		response.Conflict(c, "idempotent_response_withheld")
+		return
+	}
+
+	c.Header(IdempotentReplayedHeader, "true")
+	c.Data(record.Status, record.ContentType, record.Body)
+}
+
+// hashingReader hashes the body of a request while it's read, the fingerprint identifies a request by its
+// route and body so reusing a key for another request is rejected
+type hashingReader struct {
+	io.ReadCloser
+	hash hash.Hash
+	size int64
+}
+
+func newHashingReader(c *gin.Context) *hashingReader {
+	h := sha256.New()
+	h.Write([]byte(c.Request.Method + " " + c.Request.URL.Path + "\n"))
+	return &hashingReader{ReadCloser: c.Request.Body, hash: h}
+}
+
+func (r *hashingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.hash.Write(p[:n])
+	r.size += int64(n)
+	return n, err
+}
+
+// drain reads what the handler left of the body, it reports false when the rest can't be read or is too large
+func (r *hashingReader) drain() bool {
+	n, err := io.Copy(io.Discard, io.LimitReader(r, maxUnreadBody+1))
+	return err == nil && n <= maxUnreadBody
+}
+
+func (r *hashingReader) fingerprint() string {
+	return hex.EncodeToString(r.hash.Sum(nil))
+}
+
+// responseRecorder keeps a copy of the response body while writing it to the client
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(data string) (int, error) {
+	w.body.WriteString(data)
+	return w.ResponseWriter.WriteString(data)
+}
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"task_mng/pkg/metrics"
+	"task_mng/pkg/ratelimit"
+	"task_mng/pkg/response"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimitKey decides who a rate limit applies to
+type RateLimitKey string
+
+const (
+	// RateLimitByIP limits each client IP
+	RateLimitByIP RateLimitKey = "ip"
+	// RateLimitByUser limits each logged in user, requests without a user are limited by IP
+	RateLimitByUser RateLimitKey = "user"
+)
+
+// RateLimitRule is the limit of a route group
+type RateLimitRule struct {
+	// Name identifies the rule in the limiter keys and the metrics, e.g. auth
+	Name   string
+	Limit  int
+	Window time.Duration
+	By     RateLimitKey
+}
+
+// RateLimit rejects requests over the limit of the rule with 429 and reports the limit in RateLimit-* headers,
+// requests are let through when the limiter fails
+func RateLimit(limiter ratelimit.Limiter, rule RateLimitRule) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		result, err := limiter.Allow(c.Request.Context(), rateLimitKey(c, rule), rule.Limit, rule.Window)
+		if err != nil {
+			slog.Error("Rate limit check failed", "rule", rule.Name, "error", err)
+			metrics.RateLimitRequestsTotal.WithLabelValues(rule.Name, "error").Inc()
+			c.Next()
+			return
+		}
+
+		reset := strconv.Itoa(int(math.Ceil(result.Reset.Seconds())))
+		c.Header("RateLimit-Limit", strconv.Itoa(result.Limit))
+		c.Header("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		c.Header("RateLimit-Reset", reset)
+
+		if !result.Allowed {
+			metrics.RateLimitRequestsTotal.WithLabelValues(rule.Name, "limited").Inc()
+			c.Header("Retry-After", reset)
+			response.TooManyRequests(c, "too_many_requests")
+			c.Abort()
+			return
+		}
+
+		metrics.RateLimitRequestsTotal.WithLabelValues(rule.Name, "allowed").Inc()
+		c.Next()
+	}
+}
+
+func rateLimitKey(c *gin.Context, rule RateLimitRule) string {
+	if rule.By == RateLimitByUser {
+		if userID, ok := c.Get("user_id"); ok {
+			return fmt.Sprintf("%s:user:%v", rule.Name, userID)
+		}
+	}
+	return fmt.Sprintf("%s:ip:%s", rule.Name, c.ClientIP())
+}
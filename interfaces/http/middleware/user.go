@@ -1,14 +1,25 @@
 package middleware
 
 import (
+	"log/slog"
+	"net/http"
 	"strconv"
+	"strings"
+	userEntity "task_mng/domain/user/entity"
 	"task_mng/pkg/jwt"
 	"task_mng/pkg/response"
 
 	"github.com/gin-gonic/gin"
 )
 
-func LoginRequired(jwtManager *jwt.Manager) gin.HandlerFunc {
+// AccessTokenAuthenticator authenticates personal access tokens
+type AccessTokenAuthenticator interface {
+	AuthenticateAccessToken(token string) (userEntity.User, userEntity.AccessToken, error)
+}
+
+// LoginRequired authenticates the request with the bearer token, either a JWT or a personal access token
+// Tokens of revoked sessions are rejected, access tokens are limited to their scopes
+func LoginRequired(jwtManager *jwt.Manager, revoker jwt.SessionRevoker, accessTokens AccessTokenAuthenticator) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -25,6 +36,11 @@ func LoginRequired(jwtManager *jwt.Manager) gin.HandlerFunc {
 
 		token := authHeader[7:]
 
+		if strings.HasPrefix(token, userEntity.AccessTokenPrefix) {
+			loginWithAccessToken(c, accessTokens, token)
+			return
+		}
+
 		claims, err := jwtManager.ValidateAccessToken(token)
 		if err != nil {
 			response.Unauthorized(c, "not_logged_in")
@@ -32,6 +48,20 @@ func LoginRequired(jwtManager *jwt.Manager) gin.HandlerFunc {
 			return
 		}
 
+		revoked, err := revoker.IsRevoked(c.Request.Context(), claims)
+		if err != nil {
+			// A revoked session must not get through while the revocations can't be checked
+			slog.Error("error checking session revocation", "error", err)
+			response.ServiceUnavailable(c, "session_check_unavailable")
+			c.Abort()
+			return
+		}
+		if revoked {
+			response.Unauthorized(c, "session_revoked")
+			c.Abort()
+			return
+		}
+
 		userID, err := strconv.ParseUint(claims.UserID, 10, 32)
 		if err != nil {
 			response.Unauthorized(c, "invalid_user_id")
@@ -40,6 +70,71 @@ func LoginRequired(jwtManager *jwt.Manager) gin.HandlerFunc {
 		}
 
 		c.Set("user_id", uint(userID))
+		c.Set("role", claims.Role)
+		c.Set("session_id", claims.SessionID)
+
+		c.Next()
+	}
+}
+
+// loginWithAccessToken authenticates the request with a personal access token, the scopes of the token
+// have to allow the request method
+func loginWithAccessToken(c *gin.Context, accessTokens AccessTokenAuthenticator, token string) {
+	usr, accessToken, err := accessTokens.AuthenticateAccessToken(token)
+	if err != nil {
+		c.Error(err)
+		c.Abort()
+		return
+	}
+
+	scope := userEntity.ScopeWrite
+	if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead || c.Request.Method == http.MethodOptions {
+		scope = userEntity.ScopeRead
+	}
+
+	// write implies read
+	if !accessToken.HasScope(scope) && !accessToken.HasScope(userEntity.ScopeWrite) {
+		response.Forbidden(c, "insufficient_scope")
+		c.Abort()
+		return
+	}
+
+	c.Set("user_id", usr.ID)
+	c.Set("role", usr.Role)
+	c.Set("access_token", accessToken)
+
+	c.Next()
+}
+
+// AdminRequired only lets admins through, it has to run after LoginRequired
+// Requests made with an access token need its admin scope too
+func AdminRequired() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString("role") != userEntity.RoleAdmin {
+			response.Forbidden(c, "admin_required")
+			c.Abort()
+			return
+		}
+
+		if accessToken, ok := c.Get("access_token"); ok && !accessToken.(userEntity.AccessToken).HasScope(userEntity.ScopeAdmin) {
+			response.Forbidden(c, "insufficient_scope")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// SessionRequired rejects requests made with an access token, it has to run after LoginRequired
+// It keeps access tokens from e.g. creating more access tokens
+func SessionRequired() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := c.Get("access_token"); ok {
+			response.Forbidden(c, "session_required")
+			c.Abort()
+			return
+		}
 
 		c.Next()
 	}
@@ -6,15 +6,34 @@ import (
 	"log/slog"
 	"net/http"
 	"task_mng/cmd/web/config"
+	attachmentR "task_mng/domain/attachment"
+	checklistR "task_mng/domain/checklist"
+	projectR "task_mng/domain/project"
+	recurrenceR "task_mng/domain/recurrence"
+	sprintR "task_mng/domain/sprint"
 	taskR "task_mng/domain/task"
+	templateR "task_mng/domain/template"
 	userR "task_mng/domain/user"
+	worklogR "task_mng/domain/worklog"
 	"task_mng/interfaces/http/handlers"
 	"task_mng/interfaces/http/middleware"
 	"task_mng/pkg/jwt"
+	"task_mng/pkg/notifier"
 	"task_mng/pkg/postgres"
+	"task_mng/pkg/ratelimit"
 	"task_mng/pkg/redis"
+	"task_mng/pkg/scheduler"
+	"task_mng/pkg/storage"
+	"task_mng/services/attachment"
+	"task_mng/services/checklist"
+	"task_mng/services/project"
+	"task_mng/services/recurrence"
+	"task_mng/services/sprint"
 	"task_mng/services/task"
+	"task_mng/services/template"
 	"task_mng/services/user"
+	"task_mng/services/worklog"
+	"time"
 
 	_ "task_mng/docs" // This is required for swagger to work
 
@@ -25,40 +44,100 @@ import (
 )
 
 type Server struct {
-	config   *config.Config
-	router   *gin.Engine
-	server   *http.Server
-	jwtMng   *jwt.Manager
-	postgres *postgres.Database
-	redis    *redis.Redis
-	handlers *handlers.Handlers
+	config            *config.Config
+	router            *gin.Engine
+	server            *http.Server
+	jwtMng            *jwt.Manager
+	revoker           jwt.SessionRevoker
+	userService       *user.Service
+	postgres          *postgres.Database
+	redis             *redis.Redis
+	limiter           ratelimit.Limiter
+	handlers          *handlers.Handlers
+	scheduler         *scheduler.Scheduler
+	taskService       *task.Service
+	recurrenceService *recurrence.Service
 }
 
-func New(config *config.Config, jwtMng *jwt.Manager, postgres *postgres.Database, redis *redis.Redis) *Server {
+func New(config *config.Config, jwtMng *jwt.Manager, postgres *postgres.Database, redis *redis.Redis, store storage.Storage, notifier notifier.Notifier) *Server {
 	router := gin.Default()
 
+	// The client IP is used for rate limits and login blocks, X-Forwarded-For is only
+	// trusted from the configured proxies so clients can't choose their own address
+	if err := router.SetTrustedProxies(config.TrustedProxies); err != nil {
+		slog.Error("Invalid trusted proxies, X-Forwarded-For is ignored", "error", err)
+		router.SetTrustedProxies(nil)
+	}
+
 	// Add Prometheus metrics middleware
 	router.Use(middleware.PrometheusMetrics())
 
+	// Render the errors handlers attach to the context
+	router.Use(middleware.ErrorHandler())
+
 	// Set max body size to 3MB
 	router.MaxMultipartMemory = 3 << 20 // 3MB
 
+	// Revocations have to outlive every refresh token issued before them
+	revoker := jwt.NewRevoker(redis, jwtMng.RefreshTokenTTL())
+
 	userRepo := userR.New(postgres)
-	userService := user.New(userRepo, jwtMng)
+	userService := user.New(userRepo, jwtMng, revoker, redis, notifier, user.Config{
+		Lockout: user.LockoutConfig{
+			MaxAttempts:   config.LoginMaxAttempts,
+			Duration:      config.LoginLockoutDuration,
+			MaxDuration:   config.LoginLockoutMaxDuration,
+			IPMaxAttempts: config.LoginIPMaxAttempts,
+			IPWindow:      config.LoginIPWindow,
+		},
+		ResetTokenTTL:        config.PasswordResetTTL,
+		VerificationTokenTTL: config.EmailVerificationTTL,
+	})
+
+	projectRepo := projectR.New(postgres)
+	projectService := project.New(projectRepo)
 
 	taskRepo := taskR.New(postgres)
-	taskService := task.New(taskRepo, redis, userRepo)
+	taskService := task.New(taskRepo, redis, userRepo, notifier, projectRepo, store)
+
+	recurrenceRepo := recurrenceR.New(postgres)
+	recurrenceService := recurrence.New(recurrenceRepo, taskRepo, taskService, userRepo)
+
+	checklistRepo := checklistR.New(postgres)
+	checklistService := checklist.New(checklistRepo, taskRepo, taskService, userRepo)
+
+	templateRepo := templateR.New(postgres)
+	templateService := template.New(templateRepo, taskService, checklistService)
+
+	attachmentRepo := attachmentR.New(postgres)
+	attachmentService := attachment.New(attachmentRepo, taskRepo, store, storage.NewSigner(config.AttachmentSigningSecret), attachment.Config{
+		MaxSize: config.AttachmentMaxSize,
+		LinkTTL: config.AttachmentLinkTTL,
+	})
+
+	worklogRepo := worklogR.New(postgres)
+	worklogService := worklog.New(worklogRepo, taskRepo, taskService, userRepo)
+
+	sprintRepo := sprintR.New(postgres)
+	sprintService := sprint.New(sprintRepo, taskRepo, taskService, userRepo)
 
 	srv := &Server{
-		config:   config,
-		router:   router,
-		jwtMng:   jwtMng,
-		postgres: postgres,
-		redis:    redis,
-		handlers: handlers.New(userService, taskService),
+		config:            config,
+		router:            router,
+		jwtMng:            jwtMng,
+		revoker:           revoker,
+		userService:       userService,
+		postgres:          postgres,
+		redis:             redis,
+		limiter:           ratelimit.WithFallback(ratelimit.NewRedis(redis), ratelimit.NewMemory()),
+		handlers:          handlers.New(userService, taskService, recurrenceService, templateService, checklistService, attachmentService, worklogService, sprintService, projectService),
+		scheduler:         scheduler.New(redis),
+		taskService:       taskService,
+		recurrenceService: recurrenceService,
 	}
 
 	srv.setupRoutes()
+	srv.setupJobs()
 
 	return srv
 }
@@ -73,12 +152,15 @@ func (s *Server) Start() error {
 		Handler: s.router,
 	}
 
+	s.scheduler.Start()
+
 	return s.server.ListenAndServe()
 }
 
 // Shutdown gracefully shuts down the server
 func (s *Server) Shutdown(ctx context.Context) error {
 	slog.Info("Shutting down HTTP server")
+	s.scheduler.Stop()
 	if s.server != nil {
 		return s.server.Shutdown(ctx)
 	}
@@ -103,27 +185,168 @@ func (s *Server) setupRoutes() {
 
 	// ********************* Auth routes *********************
 	auth := v1.Group("/auth")
+	auth.Use(middleware.RateLimit(s.limiter, middleware.RateLimitRule{
+		Name:   "auth",
+		Limit:  s.config.AuthRateLimit,
+		Window: s.config.AuthRateWindow,
+		By:     middleware.RateLimitByIP,
+	}))
 	auth.POST("/login", s.handlers.User.Login)
 	auth.POST("/refresh", s.handlers.User.Refresh)
+	auth.POST("/password/forgot", s.handlers.User.ForgotPassword)
+	auth.POST("/password/reset", s.handlers.User.ResetPassword)
+	auth.POST("/email/verify", s.handlers.User.VerifyEmail)
 
 	protected := v1.Group("")
-	protected.Use(middleware.LoginRequired(s.jwtMng))
+	protected.Use(middleware.LoginRequired(s.jwtMng, s.revoker, s.userService))
+	protected.Use(middleware.RateLimit(s.limiter, middleware.RateLimitRule{
+		Name:   "api",
+		Limit:  s.config.APIRateLimit,
+		Window: s.config.APIRateWindow,
+		By:     middleware.RateLimitByUser,
+	}))
+	protected.Use(middleware.Idempotency(s.redis, s.config.IdempotencyTTL))
 
 	user := protected.Group("/users")
 	user.POST("", s.handlers.User.Create)
 	user.GET("", s.handlers.User.FindAll)
+	user.POST("/:id/unlock", middleware.AdminRequired(), s.handlers.User.Unlock)
 
 	profile := protected.Group("/profile")
 	profile.GET("", s.handlers.User.Me)
-	profile.PUT("", s.handlers.User.Update)
+	// Access tokens can't change the email, it would let them take over the account with a password reset
+	profile.PUT("", middleware.SessionRequired(), s.handlers.User.Update)
+	profile.PUT("/password", middleware.SessionRequired(), s.handlers.User.ChangePassword)
+	profile.POST("/email/verification", s.handlers.User.ResendVerification)
+
+	// ********************* Access token routes *********************
+	profile.GET("/tokens", s.handlers.User.AccessTokens)
+	profile.POST("/tokens", middleware.SessionRequired(), middleware.WithholdIdempotentResponse(), s.handlers.User.CreateAccessToken)
+	profile.DELETE("/tokens/:id", s.handlers.User.RevokeAccessToken)
+
+	// ********************* Me routes *********************
+	me := protected.Group("/me")
+	me.GET("/mentions", s.handlers.Task.Mentions)
 
 	// ********************* Task routes *********************
 	task := protected.Group("/tasks")
 	task.POST("", s.handlers.Task.Create)
+	task.POST("/from-template/:id", s.handlers.Template.CreateTask)
 	task.GET("/:id", s.handlers.Task.FindByID)
 	task.GET("", s.handlers.Task.FindAll)
 	task.PUT("/:id", s.handlers.Task.Update)
+	task.PATCH("/:id", s.handlers.Task.Patch)
 	task.PUT("/transition", s.handlers.Task.Transition)
 	task.PUT("/assign", s.handlers.Task.Assign)
 	task.DELETE("/:id", s.handlers.Task.Delete)
+
+	// ********************* Archive routes *********************
+	task.POST("/:id/archive", s.handlers.Task.Archive)
+	task.POST("/:id/unarchive", s.handlers.Task.Unarchive)
+
+	// ********************* Trash routes *********************
+	task.GET("/trash", s.handlers.Task.Trash)
+	task.POST("/:id/restore", s.handlers.Task.Restore)
+	task.DELETE("/:id/permanent", middleware.AdminRequired(), s.handlers.Task.Purge)
+
+	// ********************* Board routes *********************
+	task.GET("/board", s.handlers.Task.Board)
+	task.GET("/board/columns", s.handlers.Task.Columns)
+	task.PUT("/board/columns/:status", middleware.AdminRequired(), s.handlers.Task.UpdateColumn)
+	task.PUT("/:id/move", s.handlers.Task.Move)
+
+	// ********************* Watcher routes *********************
+	task.GET("/:id/watchers", s.handlers.Task.Watchers)
+	task.POST("/:id/watch", s.handlers.Task.Watch)
+	task.DELETE("/:id/watch", s.handlers.Task.Unwatch)
+
+	// ********************* Checklist routes *********************
+	task.GET("/:id/checklist", s.handlers.Checklist.FindAll)
+	task.POST("/:id/checklist", s.handlers.Checklist.Create)
+	task.PUT("/:id/checklist/reorder", s.handlers.Checklist.Reorder)
+	task.PUT("/:id/checklist/:item_id", s.handlers.Checklist.Update)
+	task.DELETE("/:id/checklist/:item_id", s.handlers.Checklist.Delete)
+
+	// ********************* Attachment routes *********************
+	task.GET("/:id/attachments", s.handlers.Attachment.FindAll)
+	task.POST("/:id/attachments", s.handlers.Attachment.Upload)
+	task.DELETE("/:id/attachments/:attachment_id", s.handlers.Attachment.Delete)
+
+	// Download links are authenticated by their signature so they work in plain browser links
+	v1.GET("/attachments/:id/download", s.handlers.Attachment.Download)
+
+	// ********************* Worklog routes *********************
+	task.GET("/:id/worklogs", s.handlers.Worklog.FindAll)
+	task.POST("/:id/worklogs", s.handlers.Worklog.Create)
+	task.DELETE("/:id/worklogs/:worklog_id", s.handlers.Worklog.Delete)
+
+	worklog := protected.Group("/worklogs")
+	worklog.GET("/timesheet", s.handlers.Worklog.Timesheet)
+
+	// ********************* Sprint routes *********************
+	sprint := protected.Group("/sprints")
+	sprint.POST("", s.handlers.Sprint.Create)
+	sprint.GET("", s.handlers.Sprint.FindAll)
+	sprint.GET("/:id", s.handlers.Sprint.FindByID)
+	sprint.PUT("/:id", s.handlers.Sprint.Update)
+	sprint.GET("/:id/tasks", s.handlers.Sprint.FindTasks)
+	sprint.POST("/:id/tasks", s.handlers.Sprint.AddTasks)
+	sprint.DELETE("/:id/tasks", s.handlers.Sprint.RemoveTasks)
+	sprint.POST("/:id/start", s.handlers.Sprint.Start)
+	sprint.POST("/:id/complete", s.handlers.Sprint.Complete)
+	sprint.GET("/:id/burndown", s.handlers.Sprint.Burndown)
+
+	// ********************* Project routes *********************
+	project := protected.Group("/projects")
+	project.POST("", s.handlers.Project.Create)
+	project.GET("", s.handlers.Project.FindAll)
+	project.GET("/:id", s.handlers.Project.FindByID)
+	project.PUT("/:id", s.handlers.Project.Update)
+	project.GET("/:id/fields", s.handlers.Project.FindFields)
+	project.POST("/:id/fields", s.handlers.Project.CreateField)
+	project.PUT("/:id/fields/:field_id", s.handlers.Project.UpdateField)
+	project.DELETE("/:id/fields/:field_id", s.handlers.Project.DeleteField)
+
+	// ********************* Recurrence routes *********************
+	recurrence := protected.Group("/recurrences")
+	recurrence.POST("", s.handlers.Recurrence.Create)
+	recurrence.GET("", s.handlers.Recurrence.FindAll)
+	recurrence.GET("/:id", s.handlers.Recurrence.FindByID)
+	recurrence.DELETE("/:id", s.handlers.Recurrence.Delete)
+
+	// ********************* Template routes *********************
+	template := protected.Group("/templates")
+	template.POST("", s.handlers.Template.Create)
+	template.GET("", s.handlers.Template.FindAll)
+	template.GET("/:id", s.handlers.Template.FindByID)
+	template.PUT("/:id", s.handlers.Template.Update)
+	template.DELETE("/:id", s.handlers.Template.Delete)
+}
+
+// setupJobs registers the background jobs
+func (s *Server) setupJobs() {
+	reminderConfig := task.ReminderConfig{
+		Window:        s.config.ReminderWindow,
+		EscalateAfter: time.Duration(s.config.EscalateAfterDays) * 24 * time.Hour,
+	}
+	s.scheduler.Register("task_reminders", s.config.ReminderInterval, func(ctx context.Context) error {
+		return s.taskService.RemindDueTasks(ctx, reminderConfig)
+	})
+	s.scheduler.Register("recurring_tasks", s.config.RecurrenceInterval, s.recurrenceService.Generate)
+
+	if s.config.ArchiveAfterDays > 0 {
+		archiveConfig := task.ArchiveConfig{
+			After: time.Duration(s.config.ArchiveAfterDays) * 24 * time.Hour,
+		}
+		s.scheduler.Register("task_archive", s.config.ArchiveInterval, func(ctx context.Context) error {
+			return s.taskService.ArchiveDoneTasks(ctx, archiveConfig)
+		})
+	}
+
+	trashConfig := task.TrashConfig{
+		Retention: time.Duration(s.config.TrashRetentionDays) * 24 * time.Hour,
+	}
+	s.scheduler.Register("trash_purge", s.config.TrashPurgeInterval, func(ctx context.Context) error {
+		return s.taskService.PurgeTrash(ctx, trashConfig)
+	})
 }
@@ -0,0 +1,108 @@
+package apperror
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Error is an error a service returns to its callers, it carries the HTTP status it is rendered with
+type Error struct {
+	// Code is the machine readable key of the error, e.g. task_not_found
+	Code string
+	// Status is the HTTP status the error is rendered with
+	Status int
+	// Message is safe to show to clients, it defaults to the code
+	Message string
+	// Details holds optional structured information about the error, e.g. the offending fields
+	Details interface{}
+	// cause is the underlying error, it is logged but never rendered
+	cause error
+}
+
+// New creates an error with the given status and code
+func New(status int, code string) *Error {
+	return &Error{Code: code, Status: status, Message: code}
+}
+
+// Error returns the code so existing comparisons on the message key keep working
+func (e *Error) Error() string {
+	return e.Code
+}
+
+// Unwrap returns the underlying error
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Wrap returns a copy of the error with the underlying error attached
+func (e *Error) Wrap(cause error) *Error {
+	copied := *e
+	copied.cause = cause
+	return &copied
+}
+
+// WithDetails returns a copy of the error with structured details attached
+func (e *Error) WithDetails(details interface{}) *Error {
+	copied := *e
+	copied.Details = details
+	return &copied
+}
+
+// BadRequest is returned for malformed requests, e.g. an id that is not a number
+func BadRequest(code string) *Error {
+	return New(http.StatusBadRequest, code)
+}
+
+// Unauthorized is returned when the caller could not be authenticated
+func Unauthorized(code string) *Error {
+	return New(http.StatusUnauthorized, code)
+}
+
+// Forbidden is returned when the caller is not allowed to do something
+func Forbidden(code string) *Error {
+	return New(http.StatusForbidden, code)
+}
+
+// NotFound is returned when the addressed resource doesn't exist
+func NotFound(code string) *Error {
+	return New(http.StatusNotFound, code)
+}
+
+// Conflict is returned when the request conflicts with the current state of the resource
+func Conflict(code string) *Error {
+	return New(http.StatusConflict, code)
+}
+
+// PreconditionFailed is returned when a precondition of the request, e.g. If-Match, doesn't hold
+func PreconditionFailed(code string) *Error {
+	return New(http.StatusPreconditionFailed, code)
+}
+
+// Locked is returned when the addressed resource is temporarily locked, e.g. an account after failed logins
+func Locked(code string) *Error {
+	return New(http.StatusLocked, code)
+}
+
+// TooManyRequests is returned when the caller sent too many requests or failed too often
+func TooManyRequests(code string) *Error {
+	return New(http.StatusTooManyRequests, code)
+}
+
+// Validation is returned when a well-formed request carries invalid values
+func Validation(code string) *Error {
+	return New(http.StatusUnprocessableEntity, code)
+}
+
+// Internal is returned for unexpected failures, the cause is kept for logging only
+func Internal(cause error) *Error {
+	return New(http.StatusInternalServerError, "internal_server_error").Wrap(cause)
+}
+
+// From converts any error to an Error, errors that aren't typed become internal errors
+func From(err error) *Error {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+	return Internal(err)
+}
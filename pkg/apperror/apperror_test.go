@@ -0,0 +1,50 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestFrom_KeepsTypedErrors(t *testing.T) {
+	err := fmt.Errorf("finding task: %w", NotFound("task_not_found"))
+
+	appErr := From(err)
+
+	if appErr.Status != http.StatusNotFound {
+		t.Errorf("Expected status %d, got %d", http.StatusNotFound, appErr.Status)
+	}
+	if appErr.Code != "task_not_found" {
+		t.Errorf("Expected code task_not_found, got %s", appErr.Code)
+	}
+}
+
+func TestFrom_HidesUntypedErrors(t *testing.T) {
+	cause := errors.New("pq: relation \"tasks\" does not exist")
+
+	appErr := From(cause)
+
+	if appErr.Status != http.StatusInternalServerError {
+		t.Errorf("Expected status %d, got %d", http.StatusInternalServerError, appErr.Status)
+	}
+	if appErr.Message != "internal_server_error" {
+		t.Errorf("Expected a safe message, got %s", appErr.Message)
+	}
+	if !errors.Is(appErr, cause) {
+		t.Error("Expected the cause to be kept for logging")
+	}
+}
+
+func TestWrap_DoesNotChangeOriginal(t *testing.T) {
+	base := Conflict("wip_limit_reached")
+
+	wrapped := base.Wrap(errors.New("cause"))
+
+	if base.Unwrap() != nil {
+		t.Error("Expected the original error to stay without cause")
+	}
+	if wrapped.Error() != "wip_limit_reached" {
+		t.Errorf("Expected the code as message, got %s", wrapped.Error())
+	}
+}
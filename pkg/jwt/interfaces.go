@@ -1,5 +1,7 @@
 package jwt
 
+import "context"
+
 // JWTManager defines the interface for JWT operations
 type JWTManager interface {
 	GenerateTokenPair(userID, email, username, role string) (*TokenPair, error)
@@ -9,3 +11,9 @@ type JWTManager interface {
 	RefreshTokens(refreshToken string) (*TokenPair, error)
 	ExtractClaims(tokenString string) (*Claims, error)
 }
+
+// SessionRevoker defines the interface for revoking the sessions of a user
+type SessionRevoker interface {
+	RevokeSessions(ctx context.Context, userID, keepSessionID string) error
+	IsRevoked(ctx context.Context, claims *Claims) (bool, error)
+}
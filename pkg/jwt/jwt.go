@@ -1,6 +1,8 @@
 package jwt
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"time"
 
@@ -14,12 +16,19 @@ var (
 	ErrTokenNotYetValid = errors.New("token not yet valid")
 )
 
+func init() {
+	// Issued-at is compared with session revocations, seconds would reject a login made right after one
+	jwt.TimePrecision = time.Millisecond
+}
+
 // Claims represents the JWT claims structure
 type Claims struct {
 	UserID   string `json:"user_id"`
 	Email    string `json:"email"`
 	Username string `json:"username"`
 	Role     string `json:"role,omitempty"`
+	// SessionID is shared by the tokens issued for one login, refreshed access tokens keep it
+	SessionID string `json:"sid,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -71,20 +80,34 @@ func NewManager(config Config) *Manager {
 	}
 }
 
-// GenerateTokenPair creates both access and refresh tokens
+// RefreshTokenTTL returns how long refresh tokens are valid
+func (m *Manager) RefreshTokenTTL() time.Duration {
+	return m.refreshTokenTTL
+}
+
+// GenerateTokenPair creates both access and refresh tokens for a new session
 func (m *Manager) GenerateTokenPair(userID, email, username, role string) (*TokenPair, error) {
+	sessionID, err := newSessionID()
+	if err != nil {
+		return nil, err
+	}
+
+	return m.generateTokenPair(userID, email, username, role, sessionID)
+}
+
+func (m *Manager) generateTokenPair(userID, email, username, role, sessionID string) (*TokenPair, error) {
 	now := time.Now()
 	expiresAt := now.Add(m.accessTokenTTL)
 
 	// Generate access token
-	accessToken, err := m.generateToken(userID, email, username, role, m.accessTokenSecret, expiresAt)
+	accessToken, err := m.generateToken(userID, email, username, role, sessionID, m.accessTokenSecret, expiresAt)
 	if err != nil {
 		return nil, err
 	}
 
 	// Generate refresh token with longer expiry
 	refreshExpiresAt := now.Add(m.refreshTokenTTL)
-	refreshToken, err := m.generateToken(userID, email, username, role, m.refreshTokenSecret, refreshExpiresAt)
+	refreshToken, err := m.generateToken(userID, email, username, role, sessionID, m.refreshTokenSecret, refreshExpiresAt)
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +129,7 @@ func (m *Manager) GenerateNewTokenPair(refreshToken string) (*TokenPair, error)
 	expiresAt := now.Add(m.accessTokenTTL)
 
 	// Generate access token
-	accessToken, err := m.generateToken(claims.UserID, claims.Email, claims.Username, claims.Role, m.accessTokenSecret, expiresAt)
+	accessToken, err := m.generateToken(claims.UserID, claims.Email, claims.Username, claims.Role, claims.SessionID, m.accessTokenSecret, expiresAt)
 	if err != nil {
 		return nil, err
 	}
@@ -120,14 +143,15 @@ func (m *Manager) GenerateNewTokenPair(refreshToken string) (*TokenPair, error)
 }
 
 // generateToken creates a JWT token with the given claims
-func (m *Manager) generateToken(userID, email, username, role, secret string, expiresAt time.Time) (string, error) {
+func (m *Manager) generateToken(userID, email, username, role, sessionID, secret string, expiresAt time.Time) (string, error) {
 	now := time.Now()
 
 	claims := Claims{
-		UserID:   userID,
-		Email:    email,
-		Username: username,
-		Role:     role,
+		UserID:    userID,
+		Email:     email,
+		Username:  username,
+		Role:      role,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(now),
@@ -191,8 +215,8 @@ func (m *Manager) RefreshTokens(refreshToken string) (*TokenPair, error) {
 		return nil, err
 	}
 
-	// Generate new token pair
-	return m.GenerateTokenPair(claims.UserID, claims.Email, claims.Username, claims.Role)
+	// Generate new token pair for the same session
+	return m.generateTokenPair(claims.UserID, claims.Email, claims.Username, claims.Role, claims.SessionID)
 }
 
 // ExtractClaims extracts claims from a token without validation
@@ -211,6 +235,15 @@ func (m *Manager) ExtractClaims(tokenString string) (*Claims, error) {
 	return claims, nil
 }
 
+// newSessionID returns a random id for the tokens of a new login
+func newSessionID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
 // GetTokenExpiry returns the expiry time from token claims
 func (c *Claims) GetTokenExpiry() time.Time {
 	if c.ExpiresAt != nil {
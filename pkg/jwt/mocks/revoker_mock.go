@@ -0,0 +1,26 @@
+package mocks
+
+import (
+	"context"
+	"task_mng/pkg/jwt"
+)
+
+// MockSessionRevoker is a mock implementation of jwt.SessionRevoker
+type MockSessionRevoker struct {
+	RevokeSessionsFunc func(ctx context.Context, userID, keepSessionID string) error
+	IsRevokedFunc      func(ctx context.Context, claims *jwt.Claims) (bool, error)
+}
+
+func (m *MockSessionRevoker) RevokeSessions(ctx context.Context, userID, keepSessionID string) error {
+	if m.RevokeSessionsFunc != nil {
+		return m.RevokeSessionsFunc(ctx, userID, keepSessionID)
+	}
+	return nil
+}
+
+func (m *MockSessionRevoker) IsRevoked(ctx context.Context, claims *jwt.Claims) (bool, error) {
+	if m.IsRevokedFunc != nil {
+		return m.IsRevokedFunc(ctx, claims)
+	}
+	return false, nil
+}
@@ -0,0 +1,67 @@
+package jwt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"task_mng/pkg/redis"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+const revocationKeyPrefix = "auth:revoked"
+
+// revocation rejects the tokens of a user issued up to Before, a unix time in milliseconds, except the ones of the kept session
+type revocation struct {
+	Before      int64  `json:"before_ms"`
+	KeepSession string `json:"keep_session,omitempty"`
+}
+
+// Revoker revokes the sessions of a user, e.g. after a password change
+// Revocations are kept until every token issued before them has expired
+type Revoker struct {
+	redis redis.RedisClient
+	ttl   time.Duration
+}
+
+// NewRevoker creates a revoker, ttl has to be at least the lifetime of refresh tokens
+func NewRevoker(redis redis.RedisClient, ttl time.Duration) *Revoker {
+	return &Revoker{redis: redis, ttl: ttl}
+}
+
+// RevokeSessions rejects all tokens of the user issued until now, except the ones of keepSessionID if it isn't empty
+func (r *Revoker) RevokeSessions(ctx context.Context, userID, keepSessionID string) error {
+	value, err := json.Marshal(revocation{Before: time.Now().UnixMilli(), KeepSession: keepSessionID})
+	if err != nil {
+		return err
+	}
+	return r.redis.Set(ctx, revocationKey(userID), value, r.ttl)
+}
+
+// IsRevoked reports whether the token the claims belong to was revoked
+func (r *Revoker) IsRevoked(ctx context.Context, claims *Claims) (bool, error) {
+	value, err := r.redis.Get(ctx, revocationKey(claims.UserID))
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	var revoked revocation
+	if err := json.Unmarshal([]byte(value), &revoked); err != nil {
+		return false, err
+	}
+
+	if revoked.KeepSession != "" && claims.SessionID == revoked.KeepSession {
+		return false, nil
+	}
+
+	return claims.IssuedAt == nil || claims.IssuedAt.UnixMilli() <= revoked.Before, nil
+}
+
+func revocationKey(userID string) string {
+	return fmt.Sprintf("%s:%s", revocationKeyPrefix, userID)
+}
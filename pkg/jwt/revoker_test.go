@@ -0,0 +1,103 @@
+package jwt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	redisMocks "task_mng/pkg/redis/mocks"
+
+	"github.com/golang-jwt/jwt/v5"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+func newRevokerTestRedis() *redisMocks.MockRedisClient {
+	store := map[string]string{}
+	return &redisMocks.MockRedisClient{
+		SetFunc: func(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+			store[key] = string(value.([]byte))
+			return nil
+		},
+		GetFunc: func(ctx context.Context, key string) (string, error) {
+			value, ok := store[key]
+			if !ok {
+				return "", goredis.Nil
+			}
+			return value, nil
+		},
+	}
+}
+
+func revokerTestClaims(sessionID string, issuedAt time.Time) *Claims {
+	return &Claims{
+		UserID:           "1",
+		SessionID:        sessionID,
+		RegisteredClaims: jwt.RegisteredClaims{IssuedAt: jwt.NewNumericDate(issuedAt)},
+	}
+}
+
+func TestRevoker_KeepsCurrentSession(t *testing.T) {
+	revoker := NewRevoker(newRevokerTestRedis(), time.Hour)
+	issuedAt := time.Now().Add(-time.Minute)
+
+	if err := revoker.RevokeSessions(context.Background(), "1", "current"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if revoked, _ := revoker.IsRevoked(context.Background(), revokerTestClaims("current", issuedAt)); revoked {
+		t.Error("Expected the kept session to stay valid")
+	}
+	if revoked, _ := revoker.IsRevoked(context.Background(), revokerTestClaims("other", issuedAt)); !revoked {
+		t.Error("Expected other sessions to be revoked")
+	}
+	if revoked, _ := revoker.IsRevoked(context.Background(), revokerTestClaims("new", time.Now().Add(2*time.Second))); revoked {
+		t.Error("Expected tokens issued after the revocation to be valid")
+	}
+}
+
+func TestRevoker_AllowsLoginRightAfterRevocation(t *testing.T) {
+	manager := NewManager(Config{AccessTokenSecret: "access", RefreshTokenSecret: "refresh"})
+	revoker := NewRevoker(newRevokerTestRedis(), time.Hour)
+
+	if err := revoker.RevokeSessions(context.Background(), "1", ""); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	// A login within the same second as the revocation, e.g. after a password reset
+	pair, _ := manager.GenerateTokenPair("1", "user@example.com", "user", "user")
+	claims, _ := manager.ValidateRefreshToken(pair.RefreshToken)
+	if revoked, _ := revoker.IsRevoked(context.Background(), claims); revoked {
+		t.Error("Expected a token issued after the revocation to be valid")
+	}
+}
+
+func TestRevoker_NothingRevoked(t *testing.T) {
+	revoker := NewRevoker(newRevokerTestRedis(), time.Hour)
+
+	revoked, err := revoker.IsRevoked(context.Background(), revokerTestClaims("current", time.Now()))
+	if err != nil || revoked {
+		t.Errorf("Expected token to be valid, got revoked=%v err=%v", revoked, err)
+	}
+}
+
+func TestGenerateTokenPair_SharesSessionID(t *testing.T) {
+	manager := NewManager(Config{AccessTokenSecret: "access", RefreshTokenSecret: "refresh"})
+
+	pair, err := manager.GenerateTokenPair("1", "user@example.com", "user", "user")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	access, _ := manager.ValidateAccessToken(pair.AccessToken)
+	refresh, _ := manager.ValidateRefreshToken(pair.RefreshToken)
+	if access.SessionID == "" || access.SessionID != refresh.SessionID {
+		t.Errorf("Expected both tokens to share a session id, got %q and %q", access.SessionID, refresh.SessionID)
+	}
+
+	refreshed, _ := manager.GenerateNewTokenPair(pair.RefreshToken)
+	claims, _ := manager.ValidateAccessToken(refreshed.AccessToken)
+	if claims.SessionID != access.SessionID {
+		t.Errorf("Expected refreshed token to keep the session id, got %q", claims.SessionID)
+	}
+}
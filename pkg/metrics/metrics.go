@@ -33,6 +33,32 @@ var (
 		},
 		[]string{"status"},
 	)
+
+	// JobRunsTotal counts background job runs by result
+	JobRunsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "job_runs_total",
+			Help: "Total number of background job runs",
+		},
+		[]string{"job", "result"},
+	)
+
+	// RateLimitRequestsTotal counts rate limited requests by rule and result
+	RateLimitRequestsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "rate_limit_requests_total",
+			Help: "Total number of requests checked by the rate limiter",
+		},
+		[]string{"rule", "result"},
+	)
+
+	// RateLimitFallbacksTotal counts the checks that fell back to the in-memory limiter
+	RateLimitFallbacksTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "rate_limit_fallbacks_total",
+			Help: "Total number of rate limit checks that fell back to the in-memory limiter",
+		},
+	)
 )
 
 // UpdateTasksCount updates the tasks count metric
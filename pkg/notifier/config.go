@@ -0,0 +1,82 @@
+package notifier
+
+import (
+	"fmt"
+	"net/mail"
+	"os"
+
+	"github.com/joho/godotenv"
+)
+
+const (
+	DriverLog  = "log"
+	DriverSMTP = "smtp"
+)
+
+type Config struct {
+	Driver string
+
+	// SMTP driver
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	SMTPFrom     string
+}
+
+// LoadConfigFromEnv loads notifier configuration from environment variables
+// The log driver is used when NOTIFIER_DRIVER is not set, it can't deliver password reset or verification tokens
+func LoadConfigFromEnv() (Config, error) {
+	// Load .env file if it exists
+	if err := godotenv.Load(); err != nil {
+		// .env file is optional, so we don't return error if it fails to load
+	}
+
+	config := Config{
+		Driver:   DriverLog, // Default: write notifications to the log
+		SMTPPort: "587",     // Default: submission port, STARTTLS is used when the server offers it
+	}
+
+	if driver := os.Getenv("NOTIFIER_DRIVER"); driver != "" {
+		config.Driver = driver
+	}
+
+	if port := os.Getenv("SMTP_PORT"); port != "" {
+		config.SMTPPort = port
+	}
+
+	config.SMTPHost = os.Getenv("SMTP_HOST")
+	config.SMTPUsername = os.Getenv("SMTP_USERNAME")
+	config.SMTPPassword = os.Getenv("SMTP_PASSWORD")
+	config.SMTPFrom = os.Getenv("SMTP_FROM")
+
+	return config, nil
+}
+
+// MustLoadConfigFromEnv loads notifier configuration from environment variables
+// Panics if the configuration cannot be loaded
+func MustLoadConfigFromEnv() Config {
+	config, err := LoadConfigFromEnv()
+	if err != nil {
+		panic(fmt.Sprintf("failed to load notifier config: %v", err))
+	}
+	return config
+}
+
+// ValidateConfig checks if the configuration is valid
+func ValidateConfig(config Config) error {
+	switch config.Driver {
+	case DriverLog:
+	case DriverSMTP:
+		if config.SMTPHost == "" || config.SMTPPort == "" {
+			return fmt.Errorf("SMTP host and port are required")
+		}
+		if _, err := mail.ParseAddress(config.SMTPFrom); err != nil {
+			return fmt.Errorf("invalid SMTP from address: %w", err)
+		}
+	default:
+		return fmt.Errorf("unknown notifier driver: %s", config.Driver)
+	}
+
+	return nil
+}
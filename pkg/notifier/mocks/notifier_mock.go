@@ -0,0 +1,18 @@
+package mocks
+
+import (
+	"context"
+	"task_mng/pkg/notifier"
+)
+
+// MockNotifier is a mock implementation of notifier.Notifier
+type MockNotifier struct {
+	NotifyFunc func(ctx context.Context, notification notifier.Notification) error
+}
+
+func (m *MockNotifier) Notify(ctx context.Context, notification notifier.Notification) error {
+	if m.NotifyFunc != nil {
+		return m.NotifyFunc(ctx, notification)
+	}
+	return nil
+}
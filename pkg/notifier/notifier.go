@@ -0,0 +1,61 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// Notification represents a message addressed to a single user
+type Notification struct {
+	UserID uint
+	// Email is the address to deliver to, notifiers that need one look it up by UserID when it's empty
+	Email   string
+	Subject string
+	Message string
+	// Token is a secret the user has to act on, e.g. a password reset token
+	// It is kept out of Message so notifiers that can't deliver it privately can leave it out
+	Token string
+}
+
+// Notifier defines the interface for delivering notifications to users
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
+
+// New creates the notifier selected by config.Driver, lookup finds the email of users for the SMTP driver
+func New(config Config, lookup AddressLookup) (Notifier, error) {
+	switch config.Driver {
+	case DriverLog:
+		return NewLogNotifier(), nil
+	case DriverSMTP:
+		return NewSMTPNotifier(config, lookup), nil
+	default:
+		return nil, fmt.Errorf("unknown notifier driver: %s", config.Driver)
+	}
+}
+
+// LogNotifier delivers notifications by writing them to the application log
+type LogNotifier struct {
+	logger *slog.Logger
+}
+
+// NewLogNotifier creates a new log based notifier
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{logger: slog.Default()}
+}
+
+// Notify writes the notification to the log, the token is redacted as logs aren't private to the user
+func (n *LogNotifier) Notify(ctx context.Context, notification Notification) error {
+	token := ""
+	if notification.Token != "" {
+		token = "[REDACTED]"
+	}
+
+	n.logger.Info("Notification sent",
+		"user_id", notification.UserID,
+		"subject", notification.Subject,
+		"message", notification.Message,
+		"token", token)
+	return nil
+}
@@ -0,0 +1,68 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"time"
+)
+
+// AddressLookup finds the email address of a user
+type AddressLookup func(userID uint) (string, error)
+
+// SMTPNotifier delivers notifications by email, tokens are sent along as the mailbox is private to the user
+type SMTPNotifier struct {
+	addr   string
+	auth   smtp.Auth
+	from   string
+	lookup AddressLookup
+	send   func(addr string, auth smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// NewSMTPNotifier creates a notifier sending through the configured SMTP server
+// Notifications without an email are sent to the address lookup finds for their user
+func NewSMTPNotifier(config Config, lookup AddressLookup) *SMTPNotifier {
+	var auth smtp.Auth
+	if config.SMTPUsername != "" {
+		auth = smtp.PlainAuth("", config.SMTPUsername, config.SMTPPassword, config.SMTPHost)
+	}
+
+	return &SMTPNotifier{
+		addr:   net.JoinHostPort(config.SMTPHost, config.SMTPPort),
+		auth:   auth,
+		from:   config.SMTPFrom,
+		lookup: lookup,
+		send:   smtp.SendMail,
+	}
+}
+
+// Notify sends the notification as a plain text email
+func (n *SMTPNotifier) Notify(ctx context.Context, notification Notification) error {
+	to := notification.Email
+	if to == "" {
+		email, err := n.lookup(notification.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to find the email of user %d: %w", notification.UserID, err)
+		}
+		to = email
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", n.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	// Subjects may contain task summaries, encoding them keeps line breaks out of the headers
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", notification.Subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(notification.Message)
+	if notification.Token != "" {
+		fmt.Fprintf(&msg, "\r\n\r\n%s\r\n", notification.Token)
+	}
+
+	return n.send(n.addr, n.auth, n.from, []string{to}, msg.Bytes())
+}
@@ -0,0 +1,88 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"net/smtp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sentMail struct {
+	addr string
+	from string
+	to   []string
+	msg  string
+}
+
+func newTestSMTPNotifier(lookup AddressLookup, sent *sentMail) *SMTPNotifier {
+	n := NewSMTPNotifier(Config{SMTPHost: "smtp.example.com", SMTPPort: "587", SMTPFrom: "noreply@example.com"}, lookup)
+	n.send = func(addr string, auth smtp.Auth, from string, to []string, msg []byte) error {
+		*sent = sentMail{addr: addr, from: from, to: to, msg: string(msg)}
+		return nil
+	}
+	return n
+}
+
+func TestSMTPNotifier_SendsTokenToEmail(t *testing.T) {
+	var sent sentMail
+	n := newTestSMTPNotifier(func(uint) (string, error) {
+		t.Fatal("the email of the notification should be used")
+		return "", nil
+	}, &sent)
+
+	err := n.Notify(context.Background(), Notification{
+		UserID:  1,
+		Email:   "new@example.com",
+		Subject: "Verify your email",
+		Message: "Use the token to verify new@example.com",
+		Token:   "secret-token",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "smtp.example.com:587", sent.addr)
+	assert.Equal(t, "noreply@example.com", sent.from)
+	assert.Equal(t, []string{"new@example.com"}, sent.to)
+	assert.Contains(t, sent.msg, "Subject: Verify your email\r\n")
+	assert.Contains(t, sent.msg, "secret-token")
+}
+
+func TestSMTPNotifier_LooksUpEmail(t *testing.T) {
+	var sent sentMail
+	n := newTestSMTPNotifier(func(userID uint) (string, error) {
+		assert.Equal(t, uint(7), userID)
+		return "user@example.com", nil
+	}, &sent)
+
+	err := n.Notify(context.Background(), Notification{UserID: 7, Subject: "Task assigned", Message: "You were assigned"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user@example.com"}, sent.to)
+}
+
+func TestSMTPNotifier_LookupFails(t *testing.T) {
+	var sent sentMail
+	n := newTestSMTPNotifier(func(uint) (string, error) {
+		return "", errors.New("record not found")
+	}, &sent)
+
+	err := n.Notify(context.Background(), Notification{UserID: 7, Subject: "Task assigned"})
+
+	assert.Error(t, err)
+	assert.Empty(t, sent.to)
+}
+
+func TestSMTPNotifier_SubjectCantAddHeaders(t *testing.T) {
+	var sent sentMail
+	n := newTestSMTPNotifier(nil, &sent)
+
+	err := n.Notify(context.Background(), Notification{
+		Email:   "user@example.com",
+		Subject: "Task \"x\"\r\nBcc: attacker@example.com",
+	})
+
+	require.NoError(t, err)
+	assert.NotContains(t, sent.msg, "\r\nBcc:")
+}
@@ -34,6 +34,8 @@ func New(cfg Config) (*Database, error) {
 		NowFunc: func() time.Time {
 			return time.Now().UTC()
 		},
+		// Report constraint violations as gorm errors, e.g. gorm.ErrDuplicatedKey
+		TranslateError: true,
 	}
 
 	db, err := gorm.Open(postgres.Open(dsn), gormConfig)
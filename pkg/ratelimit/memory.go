@@ -0,0 +1,69 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often expired counters are dropped
+const sweepInterval = time.Minute
+
+type memoryCounter struct {
+	slot     int64
+	current  int64
+	previous int64
+	expires  time.Time
+}
+
+type memoryLimiter struct {
+	mu        sync.Mutex
+	counters  map[string]*memoryCounter
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemory creates a limiter local to this instance
+func NewMemory() Limiter {
+	return &memoryLimiter{counters: make(map[string]*memoryCounter), now: time.Now}
+}
+
+func (l *memoryLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (Result, error) {
+	now := l.now()
+	slot := now.UnixNano() / int64(window)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	counter, ok := l.counters[key]
+	switch {
+	case !ok:
+		counter = &memoryCounter{slot: slot}
+		l.counters[key] = counter
+	case counter.slot == slot-1:
+		counter.slot, counter.previous, counter.current = slot, counter.current, 0
+	case counter.slot != slot:
+		counter.slot, counter.previous, counter.current = slot, 0, 0
+	}
+
+	counter.current++
+	counter.expires = now.Add(2 * window)
+
+	return slidingWindow(now, window, counter.previous, counter.current, limit), nil
+}
+
+// sweep drops the counters that can't affect any window anymore, it has to be called with the lock held
+func (l *memoryLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	for key, counter := range l.counters {
+		if now.After(counter.expires) {
+			delete(l.counters, key)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package ratelimit
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"task_mng/pkg/metrics"
+	"time"
+)
+
+// Result is the outcome of a rate limit check
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Reset is the time until the current window ends
+	Reset time.Duration
+}
+
+// Limiter counts requests per key in a sliding window
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (Result, error)
+}
+
+// slidingWindow estimates the requests of the last window from the counts of the current and the previous
+// fixed window, the previous one is weighted by how much of it still overlaps the sliding window
+func slidingWindow(now time.Time, window time.Duration, previous, current int64, limit int) Result {
+	elapsed := now.Sub(now.Truncate(window))
+	weight := 1 - float64(elapsed)/float64(window)
+	estimate := int(math.Ceil(float64(previous)*weight)) + int(current)
+
+	remaining := limit - estimate
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return Result{
+		Allowed:   estimate <= limit,
+		Limit:     limit,
+		Remaining: remaining,
+		Reset:     window - elapsed,
+	}
+}
+
+type fallbackLimiter struct {
+	primary   Limiter
+	secondary Limiter
+	logger    *slog.Logger
+}
+
+// WithFallback uses the secondary limiter whenever the primary one fails, e.g. when Redis is down
+func WithFallback(primary, secondary Limiter) Limiter {
+	return &fallbackLimiter{primary: primary, secondary: secondary, logger: slog.Default()}
+}
+
+func (l *fallbackLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (Result, error) {
+	result, err := l.primary.Allow(ctx, key, limit, window)
+	if err == nil {
+		return result, nil
+	}
+
+	l.logger.Warn("Rate limiter failed, falling back", "key", key, "error", err)
+	metrics.RateLimitFallbacksTotal.Inc()
+
+	return l.secondary.Allow(ctx, key, limit, window)
+}
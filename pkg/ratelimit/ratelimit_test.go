@@ -0,0 +1,114 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	redisMocks "task_mng/pkg/redis/mocks"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+func TestSlidingWindow_WeightsPreviousWindow(t *testing.T) {
+	window := time.Minute
+	now := time.Date(2025, 1, 1, 10, 0, 15, 0, time.UTC) // a quarter into the window
+
+	result := slidingWindow(now, window, 8, 3, 10)
+
+	// 8 * 0.75 + 3 = 9
+	if !result.Allowed || result.Remaining != 1 {
+		t.Errorf("Expected 1 request remaining, got allowed=%v remaining=%d", result.Allowed, result.Remaining)
+	}
+	if result.Reset != 45*time.Second {
+		t.Errorf("Expected reset in 45s, got %v", result.Reset)
+	}
+
+	result = slidingWindow(now, window, 8, 5, 10)
+	if result.Allowed || result.Remaining != 0 {
+		t.Errorf("Expected request to be limited, got allowed=%v remaining=%d", result.Allowed, result.Remaining)
+	}
+}
+
+func TestMemory_LimitsPerKey(t *testing.T) {
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	limiter := NewMemory().(*memoryLimiter)
+	limiter.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		result, _ := limiter.Allow(context.Background(), "a", 3, time.Minute)
+		if !result.Allowed {
+			t.Fatalf("Expected request %d to be allowed", i+1)
+		}
+	}
+
+	result, _ := limiter.Allow(context.Background(), "a", 3, time.Minute)
+	if result.Allowed {
+		t.Error("Expected the fourth request to be limited")
+	}
+
+	result, _ = limiter.Allow(context.Background(), "b", 3, time.Minute)
+	if !result.Allowed {
+		t.Error("Expected another key to have its own limit")
+	}
+
+	// Two windows later nothing of the earlier requests is left
+	now = now.Add(2 * time.Minute)
+	result, _ = limiter.Allow(context.Background(), "a", 3, time.Minute)
+	if !result.Allowed || result.Remaining != 2 {
+		t.Errorf("Expected the limit to be reset, got allowed=%v remaining=%d", result.Allowed, result.Remaining)
+	}
+}
+
+func TestRedis_CountsCurrentAndPreviousWindow(t *testing.T) {
+	now := time.Date(2025, 1, 1, 10, 0, 30, 0, time.UTC)
+	slot := now.UnixNano() / int64(time.Minute)
+
+	redisMock := &redisMocks.MockRedisClient{
+		IncrExpireFunc: func(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+			if key != "ratelimit:auth:ip:1.2.3.4:"+strconv.FormatInt(slot, 10) {
+				t.Errorf("Unexpected key %s", key)
+			}
+			if expiration != 2*time.Minute {
+				t.Errorf("Expected the counter to outlive the next window, got %v", expiration)
+			}
+			return 4, nil
+		},
+		GetFunc: func(ctx context.Context, key string) (string, error) {
+			if key != "ratelimit:auth:ip:1.2.3.4:"+strconv.FormatInt(slot-1, 10) {
+				return "", goredis.Nil
+			}
+			return "10", nil
+		},
+	}
+	limiter := NewRedis(redisMock).(*redisLimiter)
+	limiter.now = func() time.Time { return now }
+
+	result, err := limiter.Allow(context.Background(), "auth:ip:1.2.3.4", 10, time.Minute)
+
+	// 10 * 0.5 + 4 = 9
+	if err != nil || !result.Allowed || result.Remaining != 1 {
+		t.Errorf("Expected 1 request remaining, got allowed=%v remaining=%d err=%v", result.Allowed, result.Remaining, err)
+	}
+}
+
+func TestWithFallback_UsesSecondaryOnError(t *testing.T) {
+	redisMock := &redisMocks.MockRedisClient{
+		IncrExpireFunc: func(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+			return 0, errors.New("connection refused")
+		},
+	}
+	limiter := WithFallback(NewRedis(redisMock), NewMemory())
+
+	result, err := limiter.Allow(context.Background(), "api:user:1", 1, time.Minute)
+	if err != nil || !result.Allowed {
+		t.Fatalf("Expected the fallback to allow the request, got allowed=%v err=%v", result.Allowed, err)
+	}
+
+	result, _ = limiter.Allow(context.Background(), "api:user:1", 1, time.Minute)
+	if result.Allowed {
+		t.Error("Expected the fallback to keep counting")
+	}
+}
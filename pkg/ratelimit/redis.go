@@ -0,0 +1,57 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"task_mng/pkg/redis"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+const keyPrefix = "ratelimit"
+
+type redisLimiter struct {
+	redis redis.RedisClient
+	now   func() time.Time
+}
+
+// NewRedis creates a limiter shared by all instances of the service
+func NewRedis(redis redis.RedisClient) Limiter {
+	return &redisLimiter{redis: redis, now: time.Now}
+}
+
+func (l *redisLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (Result, error) {
+	now := l.now()
+	slot := now.UnixNano() / int64(window)
+
+	// The counter of a window is still needed while it is the previous one
+	current, err := l.redis.IncrExpire(ctx, windowKey(key, slot), 2*window)
+	if err != nil {
+		return Result{}, err
+	}
+
+	previous, err := l.count(ctx, windowKey(key, slot-1))
+	if err != nil {
+		return Result{}, err
+	}
+
+	return slidingWindow(now, window, previous, current, limit), nil
+}
+
+func (l *redisLimiter) count(ctx context.Context, key string) (int64, error) {
+	value, err := l.redis.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
+
+func windowKey(key string, slot int64) string {
+	return fmt.Sprintf("%s:%s:%d", keyPrefix, key, slot)
+}
@@ -8,6 +8,7 @@ import (
 // MockRedisClient is a mock implementation of redis.RedisClient
 type MockRedisClient struct {
 	SetFunc         func(ctx context.Context, key string, value interface{}, expiration time.Duration) error
+	SetNXFunc       func(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error)
 	GetFunc         func(ctx context.Context, key string) (string, error)
 	DelFunc         func(ctx context.Context, keys ...string) error
 	ExistsFunc      func(ctx context.Context, keys ...string) (int64, error)
@@ -15,6 +16,7 @@ type MockRedisClient struct {
 	TTLFunc         func(ctx context.Context, key string) (time.Duration, error)
 	IncrFunc        func(ctx context.Context, key string) error
 	IncrByFunc      func(ctx context.Context, key string, value int64) error
+	IncrExpireFunc  func(ctx context.Context, key string, expiration time.Duration) (int64, error)
 	HealthCheckFunc func() error
 	CloseFunc       func() error
 }
@@ -26,6 +28,13 @@ func (m *MockRedisClient) Set(ctx context.Context, key string, value interface{}
 	return nil
 }
 
+func (m *MockRedisClient) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	if m.SetNXFunc != nil {
+		return m.SetNXFunc(ctx, key, value, expiration)
+	}
+	return true, nil
+}
+
 func (m *MockRedisClient) Get(ctx context.Context, key string) (string, error) {
 	if m.GetFunc != nil {
 		return m.GetFunc(ctx, key)
@@ -75,6 +84,13 @@ func (m *MockRedisClient) IncrBy(ctx context.Context, key string, value int64) e
 	return nil
 }
 
+func (m *MockRedisClient) IncrExpire(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+	if m.IncrExpireFunc != nil {
+		return m.IncrExpireFunc(ctx, key, expiration)
+	}
+	return 1, nil
+}
+
 func (m *MockRedisClient) HealthCheck() error {
 	if m.HealthCheckFunc != nil {
 		return m.HealthCheckFunc()
@@ -12,6 +12,7 @@ import (
 // RedisClient defines the interface for Redis operations
 type RedisClient interface {
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
+	SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error)
 	Get(ctx context.Context, key string) (string, error)
 	Del(ctx context.Context, keys ...string) error
 	Exists(ctx context.Context, keys ...string) (int64, error)
@@ -19,6 +20,7 @@ type RedisClient interface {
 	TTL(ctx context.Context, key string) (time.Duration, error)
 	Incr(ctx context.Context, key string) error
 	IncrBy(ctx context.Context, key string, value int64) error
+	IncrExpire(ctx context.Context, key string, expiration time.Duration) (int64, error)
 	HealthCheck() error
 	Close() error
 }
@@ -65,6 +67,12 @@ func (r *Redis) Set(ctx context.Context, key string, value interface{}, expirati
 	return r.client.Set(ctx, key, value, expiration).Err()
 }
 
+// SetNX sets a key-value pair with expiration only if the key does not exist
+// Returns true if the key was set
+func (r *Redis) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	return r.client.SetNX(ctx, key, value, expiration).Result()
+}
+
 // Get gets a value by key
 func (r *Redis) Get(ctx context.Context, key string) (string, error) {
 	return r.client.Get(ctx, key).Result()
@@ -100,6 +108,21 @@ func (r *Redis) IncrBy(ctx context.Context, key string, value int64) error {
 	return r.client.IncrBy(ctx, key, value).Err()
 }
 
+// IncrExpire increments a key and sets its expiration in one round trip
+// Returns the incremented value
+func (r *Redis) IncrExpire(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+	var incr *redis.IntCmd
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pipe.Expire(ctx, key, expiration)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
 // HealthCheck checks if Redis is healthy
 func (r *Redis) HealthCheck() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -1,17 +1,33 @@
 package response
 
 import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"task_mng/pkg/apperror"
+
 	"github.com/asaskevich/govalidator"
 	"github.com/gin-gonic/gin"
 )
 
+// FieldError describes a single field of a request that failed validation
+type FieldError struct {
+	// Field is the json or query name of the field, nested fields are joined with dots
+	Field string `json:"field"`
+	// Rule is the validator that failed, e.g. required or length
+	Rule string `json:"rule"`
+	// Message is the message key of the failure, e.g. summary_is_required
+	Message string `json:"message"`
+}
+
 func Parse[T any](c *gin.Context) (*T, error) {
 	var form T
 	if err := c.ShouldBindJSON(&form); err != nil {
-		return nil, err
+		return nil, bindError(err, "invalid_request_body")
 	}
 
-	if _, err := govalidator.ValidateStruct(form); err != nil {
+	if err := validate(form); err != nil {
 		return nil, err
 	}
 
@@ -21,8 +37,63 @@ func Parse[T any](c *gin.Context) (*T, error) {
 func ParseQuery[T any](c *gin.Context) (*T, error) {
 	var form T
 	if err := c.ShouldBindQuery(&form); err != nil {
+		return nil, bindError(err, "invalid_query_parameters")
+	}
+
+	if err := validate(form); err != nil {
 		return nil, err
 	}
 
 	return &form, nil
 }
+
+// validate runs the valid tags of the form and reports every failing field
+func validate(form interface{}) error {
+	_, err := govalidator.ValidateStruct(form)
+	if err == nil {
+		return nil
+	}
+
+	fields := fieldErrors(err)
+	if len(fields) == 0 {
+		return apperror.Validation("validation_failed").Wrap(err)
+	}
+
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].Field < fields[j].Field })
+	return apperror.Validation("validation_failed").WithDetails(fields).Wrap(err)
+}
+
+// bindError reports values of the wrong type as field errors, anything else is a malformed request
+func bindError(err error, code string) error {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return apperror.Validation("validation_failed").WithDetails([]FieldError{{
+			Field:   typeErr.Field,
+			Rule:    "type",
+			Message: "invalid_" + typeErr.Field,
+		}}).Wrap(err)
+	}
+
+	return apperror.BadRequest(code).Wrap(err)
+}
+
+// fieldErrors flattens the nested errors govalidator returns
+func fieldErrors(err error) []FieldError {
+	var fields []FieldError
+
+	switch e := err.(type) {
+	case govalidator.Errors:
+		for _, inner := range e {
+			fields = append(fields, fieldErrors(inner)...)
+		}
+	case govalidator.Error:
+		name := strings.Join(append(append([]string{}, e.Path...), e.Name), ".")
+		message := "invalid_" + e.Name
+		if e.CustomErrorMessageExists {
+			message = e.Err.Error()
+		}
+		fields = append(fields, FieldError{Field: name, Rule: e.Validator, Message: message})
+	}
+
+	return fields
+}
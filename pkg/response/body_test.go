@@ -0,0 +1,75 @@
+package response
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"task_mng/pkg/apperror"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type parseTestRequest struct {
+	Summary  string `json:"summary" form:"summary" valid:"required~summary_is_required"`
+	Assignee string `json:"assignee" form:"assignee" valid:"required~assignee_is_required"`
+	Email    string `json:"email" form:"email" valid:"email"`
+	Count    int    `json:"count" form:"count"`
+}
+
+func parseTestContext(method, target, body string) *gin.Context {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(method, target, strings.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+	return c
+}
+
+func TestParse_ReportsEveryField(t *testing.T) {
+	c := parseTestContext(http.MethodPost, "/", `{"email": "not-an-email"}`)
+
+	_, err := Parse[parseTestRequest](c)
+
+	var appErr *apperror.Error
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, http.StatusUnprocessableEntity, appErr.Status)
+	assert.Equal(t, "validation_failed", appErr.Code)
+	assert.Equal(t, []FieldError{
+		{Field: "assignee", Rule: "required", Message: "assignee_is_required"},
+		{Field: "email", Rule: "email", Message: "invalid_email"},
+		{Field: "summary", Rule: "required", Message: "summary_is_required"},
+	}, appErr.Details)
+}
+
+func TestParse_WrongType(t *testing.T) {
+	c := parseTestContext(http.MethodPost, "/", `{"summary": "Summary", "assignee": "admin", "count": "two"}`)
+
+	_, err := Parse[parseTestRequest](c)
+
+	var appErr *apperror.Error
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, []FieldError{{Field: "count", Rule: "type", Message: "invalid_count"}}, appErr.Details)
+}
+
+func TestParse_MalformedBody(t *testing.T) {
+	c := parseTestContext(http.MethodPost, "/", `{"summary": `)
+
+	_, err := Parse[parseTestRequest](c)
+
+	var appErr *apperror.Error
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, http.StatusBadRequest, appErr.Status)
+	assert.Equal(t, "invalid_request_body", appErr.Code)
+}
+
+func TestParseQuery_ReportsEveryField(t *testing.T) {
+	c := parseTestContext(http.MethodGet, "/?summary=Summary", "")
+
+	_, err := ParseQuery[parseTestRequest](c)
+
+	var appErr *apperror.Error
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, []FieldError{{Field: "assignee", Rule: "required", Message: "assignee_is_required"}}, appErr.Details)
+}
@@ -0,0 +1,32 @@
+package response
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"task_mng/pkg/apperror"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ETag formats the version of a resource as an entity tag
+func ETag(version uint) string {
+	return fmt.Sprintf("\"%d\"", version)
+}
+
+// IfMatch returns the version expected by the If-Match header, nil when the header is missing or "*"
+func IfMatch(c *gin.Context) (*uint, error) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	value := strings.Trim(strings.TrimPrefix(header, "W/"), "\"")
+	version, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return nil, apperror.BadRequest("invalid_if_match_header").Wrap(err)
+	}
+
+	result := uint(version)
+	return &result, nil
+}
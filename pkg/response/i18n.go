@@ -0,0 +1,62 @@
+package response
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Languages the messages are translated to
+const (
+	LanguageEnglish = "en"
+	LanguagePersian = "fa"
+)
+
+// DefaultLanguage is used when the client doesn't accept any of the supported languages
+const DefaultLanguage = LanguageEnglish
+
+var catalogs = map[string]map[string]string{
+	LanguageEnglish: messagesEN,
+	LanguagePersian: messagesFA,
+}
+
+// Language returns the supported language the client prefers according to its Accept-Language header
+func Language(c *gin.Context) string {
+	return negotiate(c.GetHeader("Accept-Language"))
+}
+
+// Translate returns the text of a message key, falling back to English and then to the key itself
+func Translate(lang, key string) string {
+	if text, ok := catalogs[lang][key]; ok {
+		return text
+	}
+	if text, ok := catalogs[DefaultLanguage][key]; ok {
+		return text
+	}
+	return key
+}
+
+// negotiate picks the supported language with the highest quality, e.g. "fa-IR,fa;q=0.9,en;q=0.8" gives fa
+func negotiate(header string) string {
+	best, bestQuality := DefaultLanguage, 0.0
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+
+		quality := 1.0
+		if params = strings.TrimSpace(params); strings.HasPrefix(params, "q=") {
+			parsed, err := strconv.ParseFloat(strings.TrimPrefix(params, "q="), 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+
+		// Only the primary subtag matters, fa-IR and fa are served the same catalog
+		lang, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+		if _, ok := catalogs[lang]; ok && quality > bestQuality {
+			best, bestQuality = lang, quality
+		}
+	}
+	return best
+}
@@ -0,0 +1,57 @@
+package response
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiate(t *testing.T) {
+	cases := map[string]string{
+		"":                          LanguageEnglish,
+		"fa":                        LanguagePersian,
+		"fa-IR,fa;q=0.9,en;q=0.8":   LanguagePersian,
+		"en-US,en;q=0.9,fa;q=0.8":   LanguageEnglish,
+		"de,fa;q=0.5":               LanguagePersian,
+		"de,fr;q=0.5":               LanguageEnglish,
+		"en;q=0.2,FA-ir;q=0.7":      LanguagePersian,
+		"fa;q=invalid,en;q=0.1":     LanguageEnglish,
+		"fa;q=0,en-GB;q=0.1,*;q=1":  LanguageEnglish,
+		" fa ; q=0.4 , en ; q=0.3 ": LanguagePersian,
+	}
+
+	for header, expected := range cases {
+		assert.Equal(t, expected, negotiate(header), header)
+	}
+}
+
+func TestTranslate_FallsBack(t *testing.T) {
+	assert.Equal(t, "تسک پیدا نشد", Translate(LanguagePersian, "task_not_found"))
+	assert.Equal(t, "Task not found", Translate("de", "task_not_found"))
+	assert.Equal(t, "some_unknown_key", Translate(LanguagePersian, "some_unknown_key"))
+}
+
+func TestCatalogs_HaveSameKeys(t *testing.T) {
+	for key := range messagesEN {
+		assert.Contains(t, messagesFA, key, "missing Persian translation")
+	}
+	for key := range messagesFA {
+		assert.Contains(t, messagesEN, key, "missing English translation")
+	}
+}
+
+func TestError_KeepsCode(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	c.Request.Header.Set("Accept-Language", "fa-IR")
+
+	NotFound(c, "task_not_found")
+
+	assert.Equal(t, "fa", recorder.Header().Get("Content-Language"))
+	assert.JSONEq(t, `{"message": "تسک پیدا نشد", "code": "task_not_found"}`, recorder.Body.String())
+}
@@ -0,0 +1,209 @@
+package response
+
+// messagesEN holds the English texts of the message keys
+var messagesEN = map[string]string{
+	"Access token created successfully":     "Access token created successfully, copy it now as it won't be shown again",
+	"Access token revoked successfully":     "Access token revoked successfully",
+	"Access tokens fetched successfully":    "Access tokens fetched successfully",
+	"Attachment deleted successfully":       "Attachment deleted successfully",
+	"Attachments retrieved successfully":    "Attachments retrieved successfully",
+	"Board fetched successfully":            "Board fetched successfully",
+	"Burndown fetched successfully":         "Burndown fetched successfully",
+	"Checklist item deleted successfully":   "Checklist item deleted successfully",
+	"Checklist item updated successfully":   "Checklist item updated successfully",
+	"Checklist reordered successfully":      "Checklist reordered successfully",
+	"Checklist retrieved successfully":      "Checklist retrieved successfully",
+	"Column updated successfully":           "Column updated successfully",
+	"Columns fetched successfully":          "Columns fetched successfully",
+	"Create successful":                     "Create successful",
+	"Deleted tasks fetched successfully":    "Deleted tasks fetched successfully",
+	"Email verified successfully":           "Email verified successfully",
+	"Field deleted successfully":            "Field deleted successfully",
+	"Field updated successfully":            "Field updated successfully",
+	"Fields fetched successfully":           "Fields fetched successfully",
+	"Login successful":                      "Login successful",
+	"Mentions fetched successfully":         "Mentions fetched successfully",
+	"Password changed successfully":         "Password changed successfully",
+	"Password reset requested":              "If an account with this email exists, a password reset token has been sent",
+	"Password reset successfully":           "Password reset successfully",
+	"Profile updated successfully":          "Profile updated successfully",
+	"Project fetched successfully":          "Project fetched successfully",
+	"Project updated successfully":          "Project updated successfully",
+	"Projects fetched successfully":         "Projects fetched successfully",
+	"Recurrence deleted successfully":       "Recurrence deleted successfully",
+	"Recurrence fetched successfully":       "Recurrence fetched successfully",
+	"Recurrences fetched successfully":      "Recurrences fetched successfully",
+	"Sprint completed successfully":         "Sprint completed successfully",
+	"Sprint fetched successfully":           "Sprint fetched successfully",
+	"Sprint started successfully":           "Sprint started successfully",
+	"Sprint tasks fetched successfully":     "Sprint tasks fetched successfully",
+	"Sprint updated successfully":           "Sprint updated successfully",
+	"Sprints fetched successfully":          "Sprints fetched successfully",
+	"Task archived successfully":            "Task archived successfully",
+	"Task assigned successfully":            "Task assigned successfully",
+	"Task deleted successfully":             "Task deleted successfully",
+	"Task fetched successfully":             "Task fetched successfully",
+	"Task moved successfully":               "Task moved successfully",
+	"Task permanently deleted successfully": "Task permanently deleted successfully",
+	"Task restored successfully":            "Task restored successfully",
+	"Task status transitioned successfully": "Task status transitioned successfully",
+	"Task unarchived successfully":          "Task unarchived successfully",
+	"Task unwatched successfully":           "Task unwatched successfully",
+	"Task updated successfully":             "Task updated successfully",
+	"Task watched successfully":             "Task watched successfully",
+	"Tasks added successfully":              "Tasks added successfully",
+	"Tasks fetched successfully":            "Tasks fetched successfully",
+	"Tasks removed successfully":            "Tasks removed successfully",
+	"Template deleted successfully":         "Template deleted successfully",
+	"Template fetched successfully":         "Template fetched successfully",
+	"Template updated successfully":         "Template updated successfully",
+	"Templates fetched successfully":        "Templates fetched successfully",
+	"Timesheet retrieved successfully":      "Timesheet retrieved successfully",
+	"Tokens refreshed successful":           "Tokens refreshed successfully",
+	"User fetched":                          "User fetched",
+	"User unlocked successfully":            "User unlocked successfully",
+	"Users fetched successfully":            "Users fetched successfully",
+	"Verification sent":                     "A new verification token has been sent to your email",
+	"Watchers fetched successfully":         "Watchers fetched successfully",
+	"Worklog deleted successfully":          "Worklog deleted successfully",
+	"Worklogs retrieved successfully":       "Worklogs retrieved successfully",
+	"access_token_expired":                  "Access token has expired",
+	"access_token_not_found":                "Access token not found",
+	"account_locked":                        "Account is locked after too many failed logins, try again later",
+	"admin_required":                        "Admin access is required",
+	"another_sprint_is_active":              "Another sprint is already active",
+	"assignee_is_required":                  "Assignee is required",
+	"attachment_not_found":                  "Attachment not found",
+	"by_weekday_requires_weekly_frequency":  "Weekdays can only be set for weekly recurrences",
+	"can't find assignee user":              "Can't find the assignee user",
+	"can't find reporter user":              "Can't find the reporter user",
+	"checklist_item_not_found":              "Checklist item not found",
+	"created":                               "Created",
+	"current_password_is_incorrect":         "Current password is incorrect",
+	"current_password_is_required":          "Current password is required",
+	"custom_field_required":                 "A required custom field is missing",
+	"download_link_expired":                 "Download link has expired",
+	"email_already_in_use":                  "Email is already in use by another user",
+	"email_already_verified":                "Email is already verified",
+	"email_is_invalid":                      "Email is invalid",
+	"email_is_required":                     "Email is required",
+	"end_date_is_required":                  "End date is required",
+	"end_date_must_be_after_start_date":     "End date must be after the start date",
+	"expires_at_must_be_in_the_future":      "Expiry must be in the future",
+	"field_key_already_exists":              "A field with this key already exists",
+	"field_not_found":                       "Field not found",
+	"field_options_not_allowed":             "This field type doesn't take options",
+	"field_options_required":                "Field options are required",
+	"file_is_empty":                         "File is empty",
+	"file_is_required":                      "File is required",
+	"file_too_large":                        "File is too large",
+	"frequency_is_required":                 "Frequency is required",
+	"full_name_is_required":                 "Full name is required",
+	"idempotency_key_in_progress":           "A request with this idempotency key is still in progress",
+	"idempotency_key_reused":                "The idempotency key was already used for a different request",
+	"idempotent_response_withheld":          "The request was already handled and its response can't be sent again",
+	"insufficient_scope":                    "The access token doesn't have the scope for this request",
+	"internal_server_error":                 "Internal server error",
+	"invalid_access_token":                  "Access token is invalid or has been revoked",
+	"invalid_authorization_header_format":   "Invalid authorization header format",
+	"invalid_by_weekday":                    "Invalid weekdays",
+	"invalid_count":                         "Invalid count",
+	"invalid_custom_field_value":            "Invalid custom field value",
+	"invalid_date":                          "Invalid date",
+	"invalid_description":                   "Invalid description",
+	"invalid_download_link":                 "Invalid download link",
+	"invalid_due_date":                      "Invalid due date",
+	"invalid_due_in_hours":                  "Invalid due in hours",
+	"invalid_estimate":                      "Invalid estimate",
+	"invalid_field_id":                      "Invalid field id",
+	"invalid_field_key":                     "Invalid field key",
+	"invalid_field_options":                 "Invalid field options",
+	"invalid_field_type":                    "Invalid field type",
+	"invalid_frequency":                     "Invalid frequency",
+	"invalid_from_date":                     "Invalid from date",
+	"invalid_id":                            "Invalid id",
+	"invalid_idempotency_key":               "Invalid idempotency key",
+	"invalid_if_match_header":               "Invalid If-Match header",
+	"invalid_interval":                      "Invalid interval",
+	"invalid_labels":                        "Invalid labels",
+	"invalid_merge_patch":                   "Invalid merge patch",
+	"invalid_mode":                          "Invalid mode",
+	"invalid_next_sprint":                   "Invalid next sprint",
+	"invalid_priority":                      "Invalid priority",
+	"invalid_query_parameters":              "Invalid query parameters",
+	"invalid_refresh_token":                 "Invalid refresh token",
+	"invalid_reporter":                      "Invalid reporter",
+	"invalid_request_body":                  "Invalid request body",
+	"invalid_reset_token":                   "Reset token is invalid or has expired",
+	"invalid_scope":                         "Scope is invalid",
+	"invalid_status":                        "Invalid status",
+	"invalid_to_date":                       "Invalid to date",
+	"invalid_user_id":                       "Invalid user id",
+	"invalid_verification_token":            "Verification token is invalid or has expired",
+	"invalid_wip_limit":                     "Invalid WIP limit",
+	"item_ids_is_required":                  "Item ids are required",
+	"item_ids_must_contain_all_items":       "Item ids must contain all items of the checklist",
+	"key_is_required":                       "Key is required",
+	"minutes_is_required":                   "Minutes are required",
+	"minutes_must_be_positive":              "Minutes must be positive",
+	"missing_template_variables":            "Template variables are missing",
+	"name_is_required":                      "Name is required",
+	"name_must_be_3_to_64_characters":       "Name must be 3 to 64 characters",
+	"name_must_be_at_most_64_characters":    "Name must be at most 64 characters",
+	"new_password_is_required":              "New password is required",
+	"new_password_must_differ":              "New password must differ from the current password",
+	"next_sprint_not_found":                 "Next sprint not found",
+	"not_logged_in":                         "You are not logged in",
+	"password_is_required":                  "Password is required",
+	"password_must_be_8_to_32_characters":   "Password must be 8 to 32 characters",
+	"priority_is_required":                  "Priority is required",
+	"project_is_required_for_custom_fields": "A project is required to set custom fields",
+	"project_name_already_exists":           "A project with this name already exists",
+	"project_not_found":                     "Project not found",
+	"recurrence_not_found":                  "Recurrence not found",
+	"refresh_token_expired":                 "Refresh token has expired",
+	"refresh_token_is_required":             "Refresh token is required",
+	"reporter_not_found":                    "Reporter not found",
+	"scopes_are_required":                   "At least one scope is required",
+	"session_check_unavailable":             "The session can't be checked right now, please try again later",
+	"session_required":                      "This request can't be made with an access token",
+	"session_revoked":                       "Session has been signed out, please log in again",
+	"sprint_is_completed":                   "Sprint is completed",
+	"sprint_is_not_active":                  "Sprint is not active",
+	"sprint_is_not_planned":                 "Sprint is not planned",
+	"sprint_not_found":                      "Sprint not found",
+	"start_date_is_required":                "Start date is required",
+	"status_is_required":                    "Status is required",
+	"summary_is_required":                   "Summary is required",
+	"task_already_archived":                 "Task is already archived",
+	"task_id_is_required":                   "Task id is required",
+	"task_ids_is_required":                  "Task ids are required",
+	"task_not_archived":                     "Task is not archived",
+	"task_not_found":                        "Task not found",
+	"task_not_in_trash":                     "Task is not in the trash",
+	"task_version_conflict":                 "Task was changed by someone else, reload it and try again",
+	"template_already_exists":               "A template with this name already exists",
+	"template_not_found":                    "Template not found",
+	"text_is_required":                      "Text is required",
+	"text_must_be_at_most_500_characters":   "Text must be at most 500 characters",
+	"timesheet_period_too_long":             "Timesheet period is too long",
+	"to_must_not_be_before_from":            "The end of the period must not be before its start",
+	"token_is_required":                     "Token is required",
+	"too_many_failed_logins":                "Too many failed logins from this address, try again later",
+	"too_many_requests":                     "Too many requests, try again later",
+	"type_is_required":                      "Type is required",
+	"unknown_custom_field":                  "Unknown custom field",
+	"unknown_field":                         "Unknown field",
+	"unsupported_patch_format":              "Unsupported patch format",
+	"until_must_be_after_start_at":          "Until must be after the start",
+	"user_already_exists":                   "User already exists",
+	"user_not_found":                        "User not found",
+	"user_not_locked":                       "User is not locked",
+	"username_is_required":                  "Username is required",
+	"username_must_be_3_to_20_characters":   "Username must be 3 to 20 characters",
+	"username_or_password_is_incorrect":     "Username or password is incorrect",
+	"validation_failed":                     "Validation failed",
+	"wip_limit_reached":                     "WIP limit of the column is reached",
+	"worklog_belongs_to_another_user":       "Worklog belongs to another user",
+	"worklog_not_found":                     "Worklog not found",
+}
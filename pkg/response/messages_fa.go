@@ -0,0 +1,209 @@
+package response
+
+// messagesFA holds the Persian texts of the message keys
+var messagesFA = map[string]string{
+	"Access token created successfully":     "توکن دسترسی با موفقیت ساخته شد، آن را همین حالا کپی کنید چون دوباره نمایش داده نمی‌شود",
+	"Access token revoked successfully":     "توکن دسترسی با موفقیت باطل شد",
+	"Access tokens fetched successfully":    "توکن‌های دسترسی با موفقیت دریافت شدند",
+	"Attachment deleted successfully":       "پیوست با موفقیت حذف شد",
+	"Attachments retrieved successfully":    "پیوست‌ها با موفقیت دریافت شدند",
+	"Board fetched successfully":            "بورد با موفقیت دریافت شد",
+	"Burndown fetched successfully":         "نمودار برن‌داون با موفقیت دریافت شد",
+	"Checklist item deleted successfully":   "آیتم چک‌لیست با موفقیت حذف شد",
+	"Checklist item updated successfully":   "آیتم چک‌لیست با موفقیت به‌روزرسانی شد",
+	"Checklist reordered successfully":      "ترتیب چک‌لیست با موفقیت تغییر کرد",
+	"Checklist retrieved successfully":      "چک‌لیست با موفقیت دریافت شد",
+	"Column updated successfully":           "ستون با موفقیت به‌روزرسانی شد",
+	"Columns fetched successfully":          "ستون‌ها با موفقیت دریافت شدند",
+	"Create successful":                     "با موفقیت ایجاد شد",
+	"Deleted tasks fetched successfully":    "تسک‌های حذف‌شده با موفقیت دریافت شدند",
+	"Email verified successfully":           "ایمیل با موفقیت تایید شد",
+	"Field deleted successfully":            "فیلد با موفقیت حذف شد",
+	"Field updated successfully":            "فیلد با موفقیت به‌روزرسانی شد",
+	"Fields fetched successfully":           "فیلدها با موفقیت دریافت شدند",
+	"Login successful":                      "ورود با موفقیت انجام شد",
+	"Mentions fetched successfully":         "منشن‌ها با موفقیت دریافت شدند",
+	"Password changed successfully":         "رمز عبور با موفقیت تغییر کرد",
+	"Password reset requested":              "در صورت وجود حسابی با این ایمیل، توکن بازیابی رمز عبور ارسال شد",
+	"Password reset successfully":           "رمز عبور با موفقیت بازنشانی شد",
+	"Profile updated successfully":          "پروفایل با موفقیت به‌روزرسانی شد",
+	"Project fetched successfully":          "پروژه با موفقیت دریافت شد",
+	"Project updated successfully":          "پروژه با موفقیت به‌روزرسانی شد",
+	"Projects fetched successfully":         "پروژه‌ها با موفقیت دریافت شدند",
+	"Recurrence deleted successfully":       "تکرار با موفقیت حذف شد",
+	"Recurrence fetched successfully":       "تکرار با موفقیت دریافت شد",
+	"Recurrences fetched successfully":      "تکرارها با موفقیت دریافت شدند",
+	"Sprint completed successfully":         "اسپرینت با موفقیت به پایان رسید",
+	"Sprint fetched successfully":           "اسپرینت با موفقیت دریافت شد",
+	"Sprint started successfully":           "اسپرینت با موفقیت شروع شد",
+	"Sprint tasks fetched successfully":     "تسک‌های اسپرینت با موفقیت دریافت شدند",
+	"Sprint updated successfully":           "اسپرینت با موفقیت به‌روزرسانی شد",
+	"Sprints fetched successfully":          "اسپرینت‌ها با موفقیت دریافت شدند",
+	"Task archived successfully":            "تسک با موفقیت بایگانی شد",
+	"Task assigned successfully":            "تسک با موفقیت واگذار شد",
+	"Task deleted successfully":             "تسک با موفقیت حذف شد",
+	"Task fetched successfully":             "تسک با موفقیت دریافت شد",
+	"Task moved successfully":               "تسک با موفقیت جابه‌جا شد",
+	"Task permanently deleted successfully": "تسک برای همیشه حذف شد",
+	"Task restored successfully":            "تسک با موفقیت بازیابی شد",
+	"Task status transitioned successfully": "وضعیت تسک با موفقیت تغییر کرد",
+	"Task unarchived successfully":          "تسک با موفقیت از بایگانی خارج شد",
+	"Task unwatched successfully":           "دنبال کردن تسک با موفقیت لغو شد",
+	"Task updated successfully":             "تسک با موفقیت به‌روزرسانی شد",
+	"Task watched successfully":             "تسک با موفقیت دنبال شد",
+	"Tasks added successfully":              "تسک‌ها با موفقیت اضافه شدند",
+	"Tasks fetched successfully":            "تسک‌ها با موفقیت دریافت شدند",
+	"Tasks removed successfully":            "تسک‌ها با موفقیت حذف شدند",
+	"Template deleted successfully":         "قالب با موفقیت حذف شد",
+	"Template fetched successfully":         "قالب با موفقیت دریافت شد",
+	"Template updated successfully":         "قالب با موفقیت به‌روزرسانی شد",
+	"Templates fetched successfully":        "قالب‌ها با موفقیت دریافت شدند",
+	"Timesheet retrieved successfully":      "تایم‌شیت با موفقیت دریافت شد",
+	"Tokens refreshed successful":           "توکن‌ها با موفقیت تمدید شدند",
+	"User fetched":                          "کاربر دریافت شد",
+	"User unlocked successfully":            "قفل حساب کاربر با موفقیت باز شد",
+	"Users fetched successfully":            "کاربران با موفقیت دریافت شدند",
+	"Verification sent":                     "توکن تایید جدید به ایمیل شما ارسال شد",
+	"Watchers fetched successfully":         "دنبال‌کنندگان با موفقیت دریافت شدند",
+	"Worklog deleted successfully":          "ثبت زمان با موفقیت حذف شد",
+	"Worklogs retrieved successfully":       "ثبت‌های زمان با موفقیت دریافت شدند",
+	"access_token_expired":                  "توکن دسترسی منقضی شده است",
+	"access_token_not_found":                "توکن دسترسی یافت نشد",
+	"account_locked":                        "حساب کاربری به دلیل ورودهای ناموفق متعدد قفل شده است، بعداً دوباره تلاش کنید",
+	"admin_required":                        "دسترسی مدیر لازم است",
+	"another_sprint_is_active":              "اسپرینت دیگری در حال اجراست",
+	"assignee_is_required":                  "مسئول تسک الزامی است",
+	"attachment_not_found":                  "پیوست پیدا نشد",
+	"by_weekday_requires_weekly_frequency":  "روزهای هفته فقط برای تکرار هفتگی قابل تنظیم است",
+	"can't find assignee user":              "کاربر مسئول پیدا نشد",
+	"can't find reporter user":              "کاربر گزارش‌دهنده پیدا نشد",
+	"checklist_item_not_found":              "آیتم چک‌لیست پیدا نشد",
+	"created":                               "ایجاد شد",
+	"current_password_is_incorrect":         "رمز عبور فعلی نادرست است",
+	"current_password_is_required":          "رمز عبور فعلی الزامی است",
+	"custom_field_required":                 "یک فیلد سفارشی الزامی مقدار ندارد",
+	"download_link_expired":                 "لینک دانلود منقضی شده است",
+	"email_already_in_use":                  "این ایمیل توسط کاربر دیگری استفاده می‌شود",
+	"email_already_verified":                "ایمیل قبلا تایید شده است",
+	"email_is_invalid":                      "ایمیل نامعتبر است",
+	"email_is_required":                     "ایمیل الزامی است",
+	"end_date_is_required":                  "تاریخ پایان الزامی است",
+	"end_date_must_be_after_start_date":     "تاریخ پایان باید بعد از تاریخ شروع باشد",
+	"expires_at_must_be_in_the_future":      "زمان انقضا باید در آینده باشد",
+	"field_key_already_exists":              "فیلدی با این کلید از قبل وجود دارد",
+	"field_not_found":                       "فیلد پیدا نشد",
+	"field_options_not_allowed":             "این نوع فیلد گزینه نمی‌پذیرد",
+	"field_options_required":                "گزینه‌های فیلد الزامی است",
+	"file_is_empty":                         "فایل خالی است",
+	"file_is_required":                      "فایل الزامی است",
+	"file_too_large":                        "حجم فایل بیش از حد مجاز است",
+	"frequency_is_required":                 "دوره تکرار الزامی است",
+	"full_name_is_required":                 "نام کامل الزامی است",
+	"idempotency_key_in_progress":           "درخواستی با این کلید یکتایی هنوز در حال پردازش است",
+	"idempotency_key_reused":                "این کلید یکتایی قبلاً برای درخواست دیگری استفاده شده است",
+	"idempotent_response_withheld":          "این درخواست قبلاً انجام شده و پاسخ آن دوباره ارسال نمی‌شود",
+	"insufficient_scope":                    "توکن دسترسی مجوز لازم برای این درخواست را ندارد",
+	"internal_server_error":                 "خطای داخلی سرور",
+	"invalid_access_token":                  "توکن دسترسی نامعتبر است یا باطل شده است",
+	"invalid_authorization_header_format":   "قالب هدر احراز هویت نامعتبر است",
+	"invalid_by_weekday":                    "روزهای هفته نامعتبر است",
+	"invalid_count":                         "تعداد نامعتبر است",
+	"invalid_custom_field_value":            "مقدار فیلد سفارشی نامعتبر است",
+	"invalid_date":                          "تاریخ نامعتبر است",
+	"invalid_description":                   "توضیحات نامعتبر است",
+	"invalid_download_link":                 "لینک دانلود نامعتبر است",
+	"invalid_due_date":                      "موعد نامعتبر است",
+	"invalid_due_in_hours":                  "مهلت ساعتی نامعتبر است",
+	"invalid_estimate":                      "تخمین زمان نامعتبر است",
+	"invalid_field_id":                      "شناسه فیلد نامعتبر است",
+	"invalid_field_key":                     "کلید فیلد نامعتبر است",
+	"invalid_field_options":                 "گزینه‌های فیلد نامعتبر است",
+	"invalid_field_type":                    "نوع فیلد نامعتبر است",
+	"invalid_frequency":                     "دوره تکرار نامعتبر است",
+	"invalid_from_date":                     "تاریخ شروع بازه نامعتبر است",
+	"invalid_id":                            "شناسه نامعتبر است",
+	"invalid_idempotency_key":               "کلید یکتایی نامعتبر است",
+	"invalid_if_match_header":               "هدر If-Match نامعتبر است",
+	"invalid_interval":                      "فاصله تکرار نامعتبر است",
+	"invalid_labels":                        "برچسب‌ها نامعتبر است",
+	"invalid_merge_patch":                   "سند merge patch نامعتبر است",
+	"invalid_mode":                          "حالت نامعتبر است",
+	"invalid_next_sprint":                   "اسپرینت بعدی نامعتبر است",
+	"invalid_priority":                      "اولویت نامعتبر است",
+	"invalid_query_parameters":              "پارامترهای کوئری نامعتبر است",
+	"invalid_refresh_token":                 "توکن تمدید نامعتبر است",
+	"invalid_reporter":                      "گزارش‌دهنده نامعتبر است",
+	"invalid_request_body":                  "بدنه درخواست نامعتبر است",
+	"invalid_reset_token":                   "توکن بازیابی نامعتبر است یا منقضی شده است",
+	"invalid_scope":                         "مجوز نامعتبر است",
+	"invalid_status":                        "وضعیت نامعتبر است",
+	"invalid_to_date":                       "تاریخ پایان بازه نامعتبر است",
+	"invalid_user_id":                       "شناسه کاربر نامعتبر است",
+	"invalid_verification_token":            "توکن تایید نامعتبر است یا منقضی شده است",
+	"invalid_wip_limit":                     "محدودیت WIP نامعتبر است",
+	"item_ids_is_required":                  "شناسه آیتم‌ها الزامی است",
+	"item_ids_must_contain_all_items":       "شناسه‌ها باید همه آیتم‌های چک‌لیست را شامل شوند",
+	"key_is_required":                       "کلید الزامی است",
+	"minutes_is_required":                   "دقیقه الزامی است",
+	"minutes_must_be_positive":              "دقیقه باید مثبت باشد",
+	"missing_template_variables":            "متغیرهای قالب مقدار ندارند",
+	"name_is_required":                      "نام الزامی است",
+	"name_must_be_3_to_64_characters":       "نام باید بین ۳ تا ۶۴ کاراکتر باشد",
+	"name_must_be_at_most_64_characters":    "نام حداکثر می‌تواند ۶۴ کاراکتر باشد",
+	"new_password_is_required":              "رمز عبور جدید الزامی است",
+	"new_password_must_differ":              "رمز عبور جدید باید با رمز عبور فعلی متفاوت باشد",
+	"next_sprint_not_found":                 "اسپرینت بعدی پیدا نشد",
+	"not_logged_in":                         "وارد حساب کاربری نشده‌اید",
+	"password_is_required":                  "رمز عبور الزامی است",
+	"password_must_be_8_to_32_characters":   "رمز عبور باید بین ۸ تا ۳۲ کاراکتر باشد",
+	"priority_is_required":                  "اولویت الزامی است",
+	"project_is_required_for_custom_fields": "برای تنظیم فیلدهای سفارشی، پروژه الزامی است",
+	"project_name_already_exists":           "پروژه‌ای با این نام از قبل وجود دارد",
+	"project_not_found":                     "پروژه پیدا نشد",
+	"recurrence_not_found":                  "تکرار پیدا نشد",
+	"refresh_token_expired":                 "توکن تمدید منقضی شده است",
+	"refresh_token_is_required":             "توکن تمدید الزامی است",
+	"reporter_not_found":                    "گزارش‌دهنده پیدا نشد",
+	"scopes_are_required":                   "حداقل یک مجوز الزامی است",
+	"session_check_unavailable":             "در حال حاضر امکان بررسی نشست وجود ندارد، لطفاً بعداً دوباره تلاش کنید",
+	"session_required":                      "این درخواست با توکن دسترسی امکان‌پذیر نیست",
+	"session_revoked":                       "نشست شما خاتمه یافته است، لطفا دوباره وارد شوید",
+	"sprint_is_completed":                   "اسپرینت به پایان رسیده است",
+	"sprint_is_not_active":                  "اسپرینت فعال نیست",
+	"sprint_is_not_planned":                 "اسپرینت در وضعیت برنامه‌ریزی نیست",
+	"sprint_not_found":                      "اسپرینت پیدا نشد",
+	"start_date_is_required":                "تاریخ شروع الزامی است",
+	"status_is_required":                    "وضعیت الزامی است",
+	"summary_is_required":                   "عنوان الزامی است",
+	"task_already_archived":                 "تسک قبلاً بایگانی شده است",
+	"task_id_is_required":                   "شناسه تسک الزامی است",
+	"task_ids_is_required":                  "شناسه تسک‌ها الزامی است",
+	"task_not_archived":                     "تسک بایگانی نشده است",
+	"task_not_found":                        "تسک پیدا نشد",
+	"task_not_in_trash":                     "تسک در سطل زباله نیست",
+	"task_version_conflict":                 "تسک توسط شخص دیگری تغییر کرده است، دوباره بارگذاری و تلاش کنید",
+	"template_already_exists":               "قالبی با این نام از قبل وجود دارد",
+	"template_not_found":                    "قالب پیدا نشد",
+	"text_is_required":                      "متن الزامی است",
+	"text_must_be_at_most_500_characters":   "متن باید حداکثر ۵۰۰ کاراکتر باشد",
+	"timesheet_period_too_long":             "بازه تایم‌شیت بیش از حد طولانی است",
+	"to_must_not_be_before_from":            "پایان بازه نباید قبل از شروع آن باشد",
+	"token_is_required":                     "توکن الزامی است",
+	"too_many_failed_logins":                "تعداد ورودهای ناموفق از این آدرس بیش از حد مجاز است، بعداً دوباره تلاش کنید",
+	"too_many_requests":                     "تعداد درخواست‌ها بیش از حد مجاز است، بعداً دوباره تلاش کنید",
+	"type_is_required":                      "نوع الزامی است",
+	"unknown_custom_field":                  "فیلد سفارشی ناشناخته است",
+	"unknown_field":                         "فیلد ناشناخته است",
+	"unsupported_patch_format":              "قالب patch پشتیبانی نمی‌شود",
+	"until_must_be_after_start_at":          "تاریخ پایان تکرار باید بعد از شروع آن باشد",
+	"user_already_exists":                   "کاربر از قبل وجود دارد",
+	"user_not_found":                        "کاربر پیدا نشد",
+	"user_not_locked":                       "حساب کاربر قفل نیست",
+	"username_is_required":                  "نام کاربری الزامی است",
+	"username_must_be_3_to_20_characters":   "نام کاربری باید بین ۳ تا ۲۰ کاراکتر باشد",
+	"username_or_password_is_incorrect":     "نام کاربری یا رمز عبور اشتباه است",
+	"validation_failed":                     "اعتبارسنجی ناموفق بود",
+	"wip_limit_reached":                     "ظرفیت WIP ستون پر شده است",
+	"worklog_belongs_to_another_user":       "این ثبت زمان متعلق به کاربر دیگری است",
+	"worklog_not_found":                     "ثبت زمان پیدا نشد",
+}
@@ -8,50 +8,91 @@ import (
 
 type Response struct {
 	Message string      `json:"message"`
+	Code    string      `json:"code,omitempty"`
 	Data    interface{} `json:"data,omitempty"`
 	Meta    *Meta       `json:"meta,omitempty"`
 }
 
+// ErrorResponse is the body of failed requests rendered from typed errors
+type ErrorResponse struct {
+	Message string      `json:"message"`
+	Code    string      `json:"code"`
+	Details interface{} `json:"details,omitempty"`
+}
+
 func Success(c *gin.Context, message string, data interface{}, meta *Meta) {
-	c.JSON(http.StatusOK, Response{Message: message, Data: data, Meta: meta})
+	c.JSON(http.StatusOK, localize(c, message, data, meta))
 }
 
 func Created(c *gin.Context, data interface{}) {
-	c.JSON(http.StatusCreated, Response{Message: "created", Data: data, Meta: nil})
+	c.JSON(http.StatusCreated, localize(c, "created", data, nil))
 }
 
 func BadRequest(c *gin.Context, message string) {
-	c.JSON(http.StatusBadRequest, Response{Message: message, Data: nil, Meta: nil})
+	c.JSON(http.StatusBadRequest, localize(c, message, nil, nil))
 }
 
 func InternalServerError(c *gin.Context, message string) {
-	c.JSON(http.StatusInternalServerError, Response{Message: message, Data: nil, Meta: nil})
+	c.JSON(http.StatusInternalServerError, localize(c, message, nil, nil))
 }
 
 func NotFound(c *gin.Context, message string) {
-	c.JSON(http.StatusNotFound, Response{Message: message, Data: nil, Meta: nil})
+	c.JSON(http.StatusNotFound, localize(c, message, nil, nil))
 }
 
 func Unauthorized(c *gin.Context, message string) {
-	c.JSON(http.StatusUnauthorized, Response{Message: message, Data: nil, Meta: nil})
+	c.JSON(http.StatusUnauthorized, localize(c, message, nil, nil))
 }
 
 func Forbidden(c *gin.Context, message string) {
-	c.JSON(http.StatusForbidden, Response{Message: message, Data: nil, Meta: nil})
+	c.JSON(http.StatusForbidden, localize(c, message, nil, nil))
 }
 
 func BadGateway(c *gin.Context, message string) {
-	c.JSON(http.StatusBadGateway, Response{Message: message, Data: nil, Meta: nil})
+	c.JSON(http.StatusBadGateway, localize(c, message, nil, nil))
 }
 
 func GatewayTimeout(c *gin.Context, message string) {
-	c.JSON(http.StatusGatewayTimeout, Response{Message: message, Data: nil, Meta: nil})
+	c.JSON(http.StatusGatewayTimeout, localize(c, message, nil, nil))
 }
 
 func ServiceUnavailable(c *gin.Context, message string) {
-	c.JSON(http.StatusServiceUnavailable, Response{Message: message, Data: nil, Meta: nil})
+	c.JSON(http.StatusServiceUnavailable, localize(c, message, nil, nil))
 }
 
 func TooManyRequests(c *gin.Context, message string) {
-	c.JSON(http.StatusTooManyRequests, Response{Message: message, Data: nil, Meta: nil})
+	c.JSON(http.StatusTooManyRequests, localize(c, message, nil, nil))
+}
+
+func RequestEntityTooLarge(c *gin.Context, message string) {
+	c.JSON(http.StatusRequestEntityTooLarge, localize(c, message, nil, nil))
+}
+
+func Conflict(c *gin.Context, message string) {
+	c.JSON(http.StatusConflict, localize(c, message, nil, nil))
+}
+
+func UnprocessableEntity(c *gin.Context, message string) {
+	c.JSON(http.StatusUnprocessableEntity, localize(c, message, nil, nil))
+}
+
+func PreconditionFailed(c *gin.Context, message string) {
+	c.JSON(http.StatusPreconditionFailed, localize(c, message, nil, nil))
+}
+
+func UnsupportedMediaType(c *gin.Context, message string) {
+	c.JSON(http.StatusUnsupportedMediaType, localize(c, message, nil, nil))
+}
+
+func Error(c *gin.Context, status int, code, message string, details interface{}) {
+	lang := Language(c)
+	c.Header("Content-Language", lang)
+	c.JSON(status, ErrorResponse{Message: Translate(lang, message), Code: code, Details: details})
+}
+
+// localize builds a response with the message translated to the language of the request, the key is kept as code
+func localize(c *gin.Context, message string, data interface{}, meta *Meta) Response {
+	lang := Language(c)
+	c.Header("Content-Language", lang)
+	return Response{Message: Translate(lang, message), Code: message, Data: data, Meta: meta}
 }
@@ -0,0 +1,112 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"task_mng/pkg/metrics"
+	"task_mng/pkg/redis"
+	"time"
+)
+
+const lockKeyPrefix = "scheduler:lock"
+
+// Job represents a periodic background job
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler runs registered jobs periodically
+// A Redis lock per job and interval slot guarantees that only one instance runs a job at a time
+type Scheduler struct {
+	redis  redis.RedisClient
+	logger *slog.Logger
+	jobs   []Job
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New creates a new scheduler
+func New(redis redis.RedisClient) *Scheduler {
+	return &Scheduler{redis: redis, logger: slog.Default()}
+}
+
+// Register adds a job that runs every interval
+func (s *Scheduler) Register(name string, interval time.Duration, run func(ctx context.Context) error) {
+	s.jobs = append(s.jobs, Job{Name: name, Interval: interval, Run: run})
+}
+
+// Start starts all registered jobs in the background
+func (s *Scheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, job)
+	}
+
+	slog.Info("Scheduler started", "jobs", len(s.jobs))
+}
+
+// Stop stops all jobs and waits for running ones to finish
+func (s *Scheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+
+	slog.Info("Stopping scheduler")
+	s.cancel()
+	s.wg.Wait()
+}
+
+// loop runs the job once on start and then on every tick until the context is cancelled
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	s.run(ctx, job)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.run(ctx, job)
+		}
+	}
+}
+
+// run executes the job if the lock for the current interval slot can be acquired
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	slot := time.Now().Truncate(job.Interval).Unix()
+	lockKey := fmt.Sprintf("%s:%s:%d", lockKeyPrefix, job.Name, slot)
+
+	acquired, err := s.redis.SetNX(ctx, lockKey, "1", job.Interval)
+	if err != nil {
+		s.logger.Error("Failed to acquire job lock", "job", job.Name, "error", err)
+		metrics.JobRunsTotal.WithLabelValues(job.Name, "lock_error").Inc()
+		return
+	}
+
+	if !acquired {
+		s.logger.Debug("Job is already running on another instance", "job", job.Name)
+		metrics.JobRunsTotal.WithLabelValues(job.Name, "skipped").Inc()
+		return
+	}
+
+	start := time.Now()
+	if err := job.Run(ctx); err != nil {
+		s.logger.Error("Job failed", "job", job.Name, "error", err)
+		metrics.JobRunsTotal.WithLabelValues(job.Name, "failed").Inc()
+		return
+	}
+
+	s.logger.Info("Job completed", "job", job.Name, "duration", time.Since(start))
+	metrics.JobRunsTotal.WithLabelValues(job.Name, "succeeded").Inc()
+}
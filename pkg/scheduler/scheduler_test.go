@@ -0,0 +1,84 @@
+package scheduler
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	redisMocks "task_mng/pkg/redis/mocks"
+)
+
+func TestRun_LockAcquired(t *testing.T) {
+	var lockKey string
+	redisMock := &redisMocks.MockRedisClient{
+		SetNXFunc: func(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+			lockKey = key
+			return true, nil
+		},
+	}
+
+	s := New(redisMock)
+
+	runs := 0
+	s.run(context.Background(), Job{
+		Name:     "test_job",
+		Interval: time.Minute,
+		Run: func(ctx context.Context) error {
+			runs++
+			return nil
+		},
+	})
+
+	if runs != 1 {
+		t.Errorf("Expected job to run once, got %d", runs)
+	}
+
+	if !strings.HasPrefix(lockKey, lockKeyPrefix+":test_job:") {
+		t.Errorf("Unexpected lock key %s", lockKey)
+	}
+}
+
+func TestRun_LockHeldByAnotherInstance(t *testing.T) {
+	redisMock := &redisMocks.MockRedisClient{
+		SetNXFunc: func(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+			return false, nil
+		},
+	}
+
+	s := New(redisMock)
+
+	runs := 0
+	s.run(context.Background(), Job{
+		Name:     "test_job",
+		Interval: time.Minute,
+		Run: func(ctx context.Context) error {
+			runs++
+			return nil
+		},
+	})
+
+	if runs != 0 {
+		t.Errorf("Expected job to be skipped, got %d runs", runs)
+	}
+}
+
+func TestStartStop(t *testing.T) {
+	s := New(&redisMocks.MockRedisClient{})
+
+	done := make(chan struct{}, 1)
+	s.Register("test_job", time.Hour, func(ctx context.Context) error {
+		done <- struct{}{}
+		return nil
+	})
+
+	s.Start()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Error("Expected job to run on start")
+	}
+
+	s.Stop()
+}
@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/joho/godotenv"
+)
+
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+type Config struct {
+	Driver string
+
+	// Local filesystem driver
+	LocalPath string
+
+	// S3 compatible driver (AWS S3, MinIO, ...)
+	Endpoint  string
+	AccessKey string
+	SecretKey string
+	Bucket    string
+	Region    string
+	UseSSL    bool
+}
+
+// LoadConfigFromEnv loads storage configuration from environment variables
+// The local driver is used when STORAGE_DRIVER is not set
+func LoadConfigFromEnv() (Config, error) {
+	// Load .env file if it exists
+	if err := godotenv.Load(); err != nil {
+		// .env file is optional, so we don't return error if it fails to load
+	}
+
+	config := Config{
+		Driver:    DriverLocal,    // Default: local filesystem
+		LocalPath: "./data/blobs", // Default: relative to the working directory
+		UseSSL:    false,
+	}
+
+	if driver := os.Getenv("STORAGE_DRIVER"); driver != "" {
+		config.Driver = driver
+	}
+
+	if path := os.Getenv("STORAGE_LOCAL_PATH"); path != "" {
+		config.LocalPath = path
+	}
+
+	config.Endpoint = os.Getenv("STORAGE_S3_ENDPOINT")
+	config.AccessKey = os.Getenv("STORAGE_S3_ACCESS_KEY")
+	config.SecretKey = os.Getenv("STORAGE_S3_SECRET_KEY")
+	config.Bucket = os.Getenv("STORAGE_S3_BUCKET")
+	config.Region = os.Getenv("STORAGE_S3_REGION")
+
+	if useSSL := os.Getenv("STORAGE_S3_USE_SSL"); useSSL != "" {
+		value, err := strconv.ParseBool(useSSL)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid STORAGE_S3_USE_SSL format: %w", err)
+		}
+		config.UseSSL = value
+	}
+
+	return config, nil
+}
+
+// MustLoadConfigFromEnv loads storage configuration from environment variables
+// Panics if the configuration cannot be loaded
+func MustLoadConfigFromEnv() Config {
+	config, err := LoadConfigFromEnv()
+	if err != nil {
+		panic(fmt.Sprintf("failed to load storage config: %v", err))
+	}
+	return config
+}
+
+// ValidateConfig checks if the configuration is valid
+func ValidateConfig(config Config) error {
+	switch config.Driver {
+	case DriverLocal:
+		if config.LocalPath == "" {
+			return fmt.Errorf("local path is required")
+		}
+	case DriverS3:
+		if config.Endpoint == "" {
+			return fmt.Errorf("S3 endpoint is required")
+		}
+		if config.AccessKey == "" || config.SecretKey == "" {
+			return fmt.Errorf("S3 access key and secret key are required")
+		}
+		if config.Bucket == "" {
+			return fmt.Errorf("S3 bucket is required")
+		}
+	default:
+		return fmt.Errorf("unknown storage driver: %s", config.Driver)
+	}
+
+	return nil
+}
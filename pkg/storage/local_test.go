@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocal_PutGetDelete(t *testing.T) {
+	store, err := NewLocal(t.TempDir())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	err = store.Put(ctx, "tasks/1/report.txt", strings.NewReader("hello"), 5, "text/plain")
+	require.NoError(t, err)
+
+	reader, err := store.Get(ctx, "tasks/1/report.txt")
+	require.NoError(t, err)
+	content, err := io.ReadAll(reader)
+	reader.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(content))
+
+	require.NoError(t, store.Delete(ctx, "tasks/1/report.txt"))
+
+	_, err = store.Get(ctx, "tasks/1/report.txt")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestLocal_DeleteMissingKey(t *testing.T) {
+	store, err := NewLocal(t.TempDir())
+	require.NoError(t, err)
+
+	assert.NoError(t, store.Delete(context.Background(), "tasks/1/missing.txt"))
+}
+
+func TestLocal_RejectsEscapingKeys(t *testing.T) {
+	store, err := NewLocal(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "../secret", "tasks/../../secret", "/"} {
+		err := store.Put(context.Background(), key, strings.NewReader("x"), 1, "text/plain")
+		assert.Error(t, err, key)
+	}
+}
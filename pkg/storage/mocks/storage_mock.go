@@ -0,0 +1,34 @@
+package mocks
+
+import (
+	"context"
+	"io"
+)
+
+// MockStorage is a mock implementation of storage.Storage
+type MockStorage struct {
+	PutFunc    func(ctx context.Context, key string, reader io.Reader, size int64, contentType string) error
+	GetFunc    func(ctx context.Context, key string) (io.ReadCloser, error)
+	DeleteFunc func(ctx context.Context, key string) error
+}
+
+func (m *MockStorage) Put(ctx context.Context, key string, reader io.Reader, size int64, contentType string) error {
+	if m.PutFunc != nil {
+		return m.PutFunc(ctx, key, reader, size, contentType)
+	}
+	return nil
+}
+
+func (m *MockStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, key)
+	}
+	return nil, nil
+}
+
+func (m *MockStorage) Delete(ctx context.Context, key string) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, key)
+	}
+	return nil
+}
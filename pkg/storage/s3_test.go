@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestS3_PutGetDelete runs against a real S3 compatible server, e.g. the MinIO service of deploy/docker-compose.yml
+// STORAGE_TEST_S3_ENDPOINT=localhost:9000 go test ./pkg/storage/...
+func TestS3_PutGetDelete(t *testing.T) {
+	endpoint := os.Getenv("STORAGE_TEST_S3_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("STORAGE_TEST_S3_ENDPOINT is not set")
+	}
+
+	store, err := NewS3(Config{
+		Driver:    DriverS3,
+		Endpoint:  endpoint,
+		AccessKey: envOrDefault("STORAGE_TEST_S3_ACCESS_KEY", "minioadmin"),
+		SecretKey: envOrDefault("STORAGE_TEST_S3_SECRET_KEY", "minioadmin"),
+		Bucket:    envOrDefault("STORAGE_TEST_S3_BUCKET", "task-mng-test"),
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	err = store.Put(ctx, "tasks/1/report.txt", strings.NewReader("hello"), 5, "text/plain")
+	require.NoError(t, err)
+
+	reader, err := store.Get(ctx, "tasks/1/report.txt")
+	require.NoError(t, err)
+	content, err := io.ReadAll(reader)
+	reader.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(content))
+
+	require.NoError(t, store.Delete(ctx, "tasks/1/report.txt"))
+
+	_, err = store.Get(ctx, "tasks/1/report.txt")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func envOrDefault(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// Signer creates and verifies expiring signatures for download links
+type Signer struct {
+	secret []byte
+}
+
+func NewSigner(secret string) *Signer {
+	return &Signer{secret: []byte(secret)}
+}
+
+// Sign returns the signature of the resource valid until expires
+func (s *Signer) Sign(resource string, expires time.Time) string {
+	mac := hmac.New(sha256.New, s.secret)
+	fmt.Fprintf(mac, "%s:%d", resource, expires.Unix())
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature was issued for the resource and has not expired yet
+func (s *Signer) Verify(resource string, expires time.Time, signature string, now time.Time) bool {
+	if now.After(expires) {
+		return false
+	}
+
+	expected := s.Sign(resource, expires)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
@@ -0,0 +1,22 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSigner_Verify(t *testing.T) {
+	signer := NewSigner("test-signing-secret")
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	expires := now.Add(15 * time.Minute)
+
+	signature := signer.Sign("attachments/1", expires)
+
+	assert.True(t, signer.Verify("attachments/1", expires, signature, now))
+	assert.False(t, signer.Verify("attachments/2", expires, signature, now), "other resource")
+	assert.False(t, signer.Verify("attachments/1", expires.Add(time.Hour), signature, now), "tampered expiry")
+	assert.False(t, signer.Verify("attachments/1", expires, signature, expires.Add(time.Second)), "expired")
+	assert.False(t, NewSigner("other-secret").Verify("attachments/1", expires, signature, now), "other secret")
+}
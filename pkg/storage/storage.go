@@ -0,0 +1,30 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrNotFound is returned when no object is stored under the requested key
+var ErrNotFound = errors.New("object not found")
+
+// Storage is a blob store addressed by slash separated keys
+type Storage interface {
+	Put(ctx context.Context, key string, reader io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// New creates the storage selected by config.Driver
+func New(config Config) (Storage, error) {
+	switch config.Driver {
+	case DriverLocal:
+		return NewLocal(config.LocalPath)
+	case DriverS3:
+		return NewS3(config)
+	default:
+		return nil, fmt.Errorf("unknown storage driver: %s", config.Driver)
+	}
+}
@@ -0,0 +1,279 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"task_mng/domain/attachment"
+	"task_mng/domain/attachment/aggregate"
+	"task_mng/domain/attachment/entity"
+	taskR "task_mng/domain/task"
+	"task_mng/pkg/apperror"
+	"task_mng/pkg/storage"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// sniffLength is the number of bytes http.DetectContentType looks at
+const sniffLength = 512
+
+type Config struct {
+	MaxSize int64         // Maximum size of a single upload in bytes
+	LinkTTL time.Duration // Lifetime of a signed download link
+}
+
+type Service struct {
+	repository     attachment.Repository
+	logger         *slog.Logger
+	taskRepository taskR.Repository
+	storage        storage.Storage
+	signer         *storage.Signer
+	config         Config
+	now            func() time.Time
+}
+
+func New(repository attachment.Repository, taskRepository taskR.Repository, storage storage.Storage, signer *storage.Signer, config Config) *Service {
+	return &Service{
+		repository:     repository,
+		logger:         slog.Default(),
+		taskRepository: taskRepository,
+		storage:        storage,
+		signer:         signer,
+		config:         config,
+		now:            time.Now,
+	}
+}
+
+// MaxSize returns the maximum accepted upload size in bytes
+func (s *Service) MaxSize() int64 {
+	return s.config.MaxSize
+}
+
+// ********************* Upload *********************
+// Upload stores the file in the blob store and records it as an attachment of the task
+// The content type is sniffed from the file content, the one sent by the client is ignored
+func (s *Service) Upload(ctx context.Context, taskID string, userID uint, fileName string, size int64, file io.Reader) (*aggregate.AttachmentResponse, error) {
+	id, err := s.findTask(taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if size <= 0 {
+		return nil, apperror.Validation("file_is_empty")
+	}
+
+	if size > s.config.MaxSize {
+		return nil, apperror.New(http.StatusRequestEntityTooLarge, "file_too_large")
+	}
+
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		s.logger.Error("error reading uploaded file", "error", err)
+		return nil, apperror.Internal(err)
+	}
+	head = head[:n]
+	contentType := http.DetectContentType(head)
+
+	name := filepath.Base(strings.ReplaceAll(fileName, "\\", "/"))
+	key, err := storageKey(id, name)
+	if err != nil {
+		s.logger.Error("error generating storage key", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	err = s.storage.Put(ctx, key, io.MultiReader(bytes.NewReader(head), file), size, contentType)
+	if err != nil {
+		s.logger.Error("error storing attachment", "key", key, "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	e := &entity.Attachment{
+		TaskID:      id,
+		FileName:    name,
+		ContentType: contentType,
+		Size:        size,
+		StorageKey:  key,
+		UploadedBy:  userID,
+	}
+
+	err = s.repository.Create(e)
+	if err != nil {
+		s.logger.Error("error creating attachment", "error", err)
+		// Don't leave an orphan blob behind
+		if err := s.storage.Delete(ctx, key); err != nil {
+			s.logger.Warn("error deleting orphan attachment blob", "key", key, "error", err)
+		}
+		return nil, apperror.Internal(err)
+	}
+
+	return s.newResponse(e), nil
+}
+
+// ********************* Find All *********************
+func (s *Service) FindAll(taskID string) ([]*aggregate.AttachmentResponse, error) {
+	id, err := s.findTask(taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.repository.FindByTaskID(id)
+	if err != nil {
+		s.logger.Error("error finding attachments", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	result := make([]*aggregate.AttachmentResponse, len(attachments))
+	for i := range attachments {
+		result[i] = s.newResponse(&attachments[i])
+	}
+
+	return result, nil
+}
+
+// ********************* Download *********************
+// Download verifies a signed download link and opens the attachment content
+// The caller has to close the returned reader
+func (s *Service) Download(ctx context.Context, id, expires, signature string) (entity.Attachment, io.ReadCloser, error) {
+	uintID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		s.logger.Error("error parsing id", "error", err)
+		return entity.Attachment{}, nil, apperror.BadRequest("invalid_id")
+	}
+
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return entity.Attachment{}, nil, apperror.Forbidden("invalid_download_link")
+	}
+	expiresAt := time.Unix(unix, 0)
+
+	if s.now().After(expiresAt) {
+		return entity.Attachment{}, nil, apperror.Forbidden("download_link_expired")
+	}
+
+	if !s.signer.Verify(resource(uint(uintID)), expiresAt, signature, s.now()) {
+		s.logger.Warn("invalid attachment download signature", "id", uintID)
+		return entity.Attachment{}, nil, apperror.Forbidden("invalid_download_link")
+	}
+
+	e, err := s.findByID(uint(uintID))
+	if err != nil {
+		return entity.Attachment{}, nil, err
+	}
+
+	reader, err := s.storage.Get(ctx, e.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			s.logger.Error("attachment blob is missing", "key", e.StorageKey)
+			return entity.Attachment{}, nil, apperror.NotFound("attachment_not_found")
+		}
+		s.logger.Error("error reading attachment", "key", e.StorageKey, "error", err)
+		return entity.Attachment{}, nil, apperror.Internal(err)
+	}
+
+	return e, reader, nil
+}
+
+// ********************* Delete *********************
+func (s *Service) Delete(ctx context.Context, taskID, id string) error {
+	tID, err := s.findTask(taskID)
+	if err != nil {
+		return err
+	}
+
+	uintID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		s.logger.Error("error parsing id", "error", err)
+		return apperror.BadRequest("invalid_id")
+	}
+
+	e, err := s.findByID(uint(uintID))
+	if err != nil {
+		return err
+	}
+
+	if e.TaskID != tID {
+		s.logger.Error("attachment belongs to another task", "attachment_id", e.ID, "task_id", tID)
+		return apperror.NotFound("attachment_not_found")
+	}
+
+	err = s.repository.Delete(e)
+	if err != nil {
+		s.logger.Error("error deleting attachment", "error", err)
+		return apperror.Internal(err)
+	}
+
+	if err := s.storage.Delete(ctx, e.StorageKey); err != nil {
+		s.logger.Warn("error deleting attachment blob", "key", e.StorageKey, "error", err)
+	}
+
+	return nil
+}
+
+// Helper functions
+func (s *Service) findTask(taskID string) (uint, error) {
+	uintID, err := strconv.ParseUint(taskID, 10, 32)
+	if err != nil {
+		s.logger.Error("error parsing id", "error", err)
+		return 0, apperror.BadRequest("invalid_id")
+	}
+
+	t, err := s.taskRepository.FindByID(uint(uintID))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding task", "error", err)
+			return 0, apperror.Internal(err)
+		}
+		s.logger.Error("task not found", "error", err)
+		return 0, apperror.NotFound("task_not_found")
+	}
+
+	return t.ID, nil
+}
+
+func (s *Service) findByID(id uint) (entity.Attachment, error) {
+	e, err := s.repository.FindByID(id)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding attachment", "error", err)
+			return entity.Attachment{}, apperror.Internal(err)
+		}
+		s.logger.Error("attachment not found", "error", err)
+		return entity.Attachment{}, apperror.NotFound("attachment_not_found")
+	}
+
+	return e, nil
+}
+
+// newResponse builds the response with a freshly signed download link
+func (s *Service) newResponse(e *entity.Attachment) *aggregate.AttachmentResponse {
+	expiresAt := s.now().Add(s.config.LinkTTL).Truncate(time.Second)
+	signature := s.signer.Sign(resource(e.ID), expiresAt)
+	url := fmt.Sprintf("/api/v1/attachments/%d/download?expires=%d&signature=%s", e.ID, expiresAt.Unix(), signature)
+
+	return aggregate.NewAttachmentResponse(e, url, expiresAt)
+}
+
+func resource(id uint) string {
+	return fmt.Sprintf("attachments/%d", id)
+}
+
+// storageKey returns a unique key for the blob, keeping the file extension for readability
+func storageKey(taskID uint, fileName string) (string, error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("tasks/%d/%s%s", taskID, hex.EncodeToString(random), strings.ToLower(filepath.Ext(fileName))), nil
+}
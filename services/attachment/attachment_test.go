@@ -0,0 +1,154 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/url"
+	"strings"
+	"task_mng/domain/attachment/entity"
+	"task_mng/domain/attachment/mocks"
+	taskEntity "task_mng/domain/task/entity"
+	taskMocks "task_mng/domain/task/mocks"
+	"task_mng/pkg/storage"
+	storageMocks "task_mng/pkg/storage/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func newTestService(store storage.Storage) (*Service, *mocks.MockAttachmentRepository, *taskMocks.MockTaskRepository) {
+	mockRepo := new(mocks.MockAttachmentRepository)
+	mockTaskRepo := new(taskMocks.MockTaskRepository)
+
+	service := New(mockRepo, mockTaskRepo, store, storage.NewSigner("test-signing-secret"), Config{
+		MaxSize: 1024,
+		LinkTTL: 15 * time.Minute,
+	})
+	service.now = func() time.Time { return time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC) }
+
+	return service, mockRepo, mockTaskRepo
+}
+
+func TestUpload_SniffsContentType(t *testing.T) {
+	var stored bytes.Buffer
+	var storedType string
+	store := &storageMocks.MockStorage{
+		PutFunc: func(ctx context.Context, key string, reader io.Reader, size int64, contentType string) error {
+			storedType = contentType
+			_, err := io.Copy(&stored, reader)
+			return err
+		},
+	}
+	service, mockRepo, mockTaskRepo := newTestService(store)
+
+	mockTaskRepo.On("FindByID", uint(1)).Return(taskEntity.Task{Model: gorm.Model{ID: 1}}, nil)
+	mockRepo.On("Create", mock.MatchedBy(func(e *entity.Attachment) bool {
+		return e.TaskID == 1 &&
+			e.FileName == "diagram.txt" &&
+			e.ContentType == "image/png" &&
+			e.UploadedBy == 7 &&
+			strings.HasPrefix(e.StorageKey, "tasks/1/") &&
+			strings.HasSuffix(e.StorageKey, ".txt")
+	})).Return(nil)
+
+	result, err := service.Upload(context.Background(), "1", 7, "../../diagram.txt", int64(len(pngHeader)), bytes.NewReader(pngHeader))
+
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", storedType)
+	assert.Equal(t, pngHeader, stored.Bytes(), "sniffed bytes must be stored as well")
+	assert.Equal(t, "image/png", result.ContentType)
+	assert.Contains(t, result.DownloadURL, "signature=")
+	mockRepo.AssertExpectations(t)
+	mockTaskRepo.AssertExpectations(t)
+}
+
+func TestUpload_TooLarge(t *testing.T) {
+	service, mockRepo, mockTaskRepo := newTestService(&storageMocks.MockStorage{})
+
+	mockTaskRepo.On("FindByID", uint(1)).Return(taskEntity.Task{Model: gorm.Model{ID: 1}}, nil)
+
+	result, err := service.Upload(context.Background(), "1", 7, "big.bin", 2048, bytes.NewReader(make([]byte, 2048)))
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "file_too_large", err.Error())
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestUpload_RemovesBlobWhenRecordFails(t *testing.T) {
+	deleted := ""
+	store := &storageMocks.MockStorage{
+		DeleteFunc: func(ctx context.Context, key string) error {
+			deleted = key
+			return nil
+		},
+	}
+	service, mockRepo, mockTaskRepo := newTestService(store)
+
+	mockTaskRepo.On("FindByID", uint(1)).Return(taskEntity.Task{Model: gorm.Model{ID: 1}}, nil)
+	mockRepo.On("Create", mock.AnythingOfType("*entity.Attachment")).Return(gorm.ErrInvalidDB)
+
+	_, err := service.Upload(context.Background(), "1", 7, "notes.txt", 5, strings.NewReader("hello"))
+
+	assert.Error(t, err)
+	assert.Equal(t, "internal_server_error", err.Error())
+	assert.True(t, strings.HasPrefix(deleted, "tasks/1/"))
+}
+
+func TestDownload_SignedLink(t *testing.T) {
+	store := &storageMocks.MockStorage{
+		GetFunc: func(ctx context.Context, key string) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader("hello")), nil
+		},
+	}
+	service, mockRepo, _ := newTestService(store)
+
+	attachment := entity.Attachment{Model: gorm.Model{ID: 3}, TaskID: 1, StorageKey: "tasks/1/abc.txt"}
+	mockRepo.On("FindByID", uint(3)).Return(attachment, nil)
+
+	link, err := url.Parse(service.newResponse(&attachment).DownloadURL)
+	require.NoError(t, err)
+
+	e, reader, err := service.Download(context.Background(), "3", link.Query().Get("expires"), link.Query().Get("signature"))
+
+	require.NoError(t, err)
+	defer reader.Close()
+	assert.Equal(t, uint(3), e.ID)
+}
+
+func TestDownload_InvalidSignature(t *testing.T) {
+	service, mockRepo, _ := newTestService(&storageMocks.MockStorage{})
+
+	attachment := entity.Attachment{Model: gorm.Model{ID: 3}}
+	link, err := url.Parse(service.newResponse(&attachment).DownloadURL)
+	require.NoError(t, err)
+
+	// A signature for attachment 3 must not open attachment 4
+	_, _, err = service.Download(context.Background(), "4", link.Query().Get("expires"), link.Query().Get("signature"))
+
+	assert.Error(t, err)
+	assert.Equal(t, "invalid_download_link", err.Error())
+	mockRepo.AssertNotCalled(t, "FindByID", mock.Anything)
+}
+
+func TestDownload_Expired(t *testing.T) {
+	service, _, _ := newTestService(&storageMocks.MockStorage{})
+
+	attachment := entity.Attachment{Model: gorm.Model{ID: 3}}
+	link, err := url.Parse(service.newResponse(&attachment).DownloadURL)
+	require.NoError(t, err)
+
+	service.now = func() time.Time { return time.Date(2025, time.January, 1, 13, 0, 0, 0, time.UTC) }
+
+	_, _, err = service.Download(context.Background(), "3", link.Query().Get("expires"), link.Query().Get("signature"))
+
+	assert.Error(t, err)
+	assert.Equal(t, "download_link_expired", err.Error())
+}
@@ -0,0 +1,322 @@
+package checklist
+
+import (
+	"errors"
+	"log/slog"
+	"strconv"
+	"task_mng/domain/checklist"
+	"task_mng/domain/checklist/aggregate"
+	"task_mng/domain/checklist/entity"
+	taskR "task_mng/domain/task"
+	"task_mng/domain/user"
+	"task_mng/pkg/apperror"
+	"task_mng/services/task"
+
+	"gorm.io/gorm"
+)
+
+type Service struct {
+	repository     checklist.Repository
+	logger         *slog.Logger
+	taskRepository taskR.Repository
+	taskService    *task.Service
+	userRepository user.Repository
+}
+
+func New(repository checklist.Repository, taskRepository taskR.Repository, taskService *task.Service, userRepository user.Repository) *Service {
+	return &Service{
+		repository:     repository,
+		logger:         slog.Default(),
+		taskRepository: taskRepository,
+		taskService:    taskService,
+		userRepository: userRepository,
+	}
+}
+
+// ********************* Find All *********************
+func (s *Service) FindAll(taskID string) ([]*aggregate.ItemResponse, error) {
+	id, err := s.findTask(taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.repository.FindByTaskID(id)
+	if err != nil {
+		s.logger.Error("error finding checklist items", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	assigneeIDs := make([]uint, 0)
+	assigneeIDMap := make(map[uint]bool)
+	for _, item := range items {
+		if item.Assignee != nil && !assigneeIDMap[*item.Assignee] {
+			assigneeIDs = append(assigneeIDs, *item.Assignee)
+			assigneeIDMap[*item.Assignee] = true
+		}
+	}
+
+	assigneeUsernames := make(map[uint]string)
+	if len(assigneeIDs) > 0 {
+		users, err := s.userRepository.FindByIDs(assigneeIDs)
+		if err != nil {
+			s.logger.Warn("error finding assignee users", "error", err)
+		} else {
+			for _, user := range users {
+				assigneeUsernames[user.ID] = user.Username
+			}
+		}
+	}
+
+	return aggregate.NewItemListResponse(items, assigneeUsernames), nil
+}
+
+// ********************* Create *********************
+type CreateRequest struct {
+	Text     string  `json:"text" valid:"required~text_is_required,length(1|500)~text_must_be_at_most_500_characters" example:"Collect logs"`
+	Assignee *string `json:"assignee" example:"admin"`
+}
+
+func (s *Service) Create(taskID string, req *CreateRequest) (*aggregate.ItemResponse, error) {
+	id, err := s.findTask(taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	assigneeID, assigneeUsername, err := s.findAssignee(req.Assignee)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.repository.FindByTaskID(id)
+	if err != nil {
+		s.logger.Error("error finding checklist items", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	e := &entity.Item{
+		TaskID:   id,
+		Text:     req.Text,
+		Assignee: assigneeID,
+		Position: len(items),
+	}
+
+	err = s.repository.Create(e)
+	if err != nil {
+		s.logger.Error("error creating checklist item", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	s.syncProgress(id, append(items, *e))
+
+	return aggregate.NewItemResponse(e, assigneeUsername), nil
+}
+
+// CreateDefaults adds the given items to the checklist of a newly created task
+func (s *Service) CreateDefaults(taskID uint, texts []string) error {
+	if len(texts) == 0 {
+		return nil
+	}
+
+	items := make([]entity.Item, len(texts))
+	for i, text := range texts {
+		items[i] = entity.Item{TaskID: taskID, Text: text, Position: i}
+	}
+
+	err := s.repository.CreateMany(items)
+	if err != nil {
+		s.logger.Error("error creating default checklist items", "task_id", taskID, "error", err)
+		return apperror.Internal(err)
+	}
+
+	s.syncProgress(taskID, items)
+
+	return nil
+}
+
+// ********************* Update *********************
+type UpdateRequest struct {
+	Text     string  `json:"text" valid:"required~text_is_required,length(1|500)~text_must_be_at_most_500_characters" example:"Collect logs"`
+	Done     bool    `json:"done" example:"true"`
+	Assignee *string `json:"assignee" example:"admin"`
+}
+
+func (s *Service) Update(taskID, itemID string, req *UpdateRequest) (*aggregate.ItemResponse, error) {
+	id, err := s.findTask(taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := s.findItem(id, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	assigneeID, assigneeUsername, err := s.findAssignee(req.Assignee)
+	if err != nil {
+		return nil, err
+	}
+
+	e.Text = req.Text
+	e.Done = req.Done
+	e.Assignee = assigneeID
+
+	err = s.repository.Update(e)
+	if err != nil {
+		s.logger.Error("error updating checklist item", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	s.refreshProgress(id)
+
+	return aggregate.NewItemResponse(&e, assigneeUsername), nil
+}
+
+// ********************* Delete *********************
+func (s *Service) Delete(taskID, itemID string) error {
+	id, err := s.findTask(taskID)
+	if err != nil {
+		return err
+	}
+
+	e, err := s.findItem(id, itemID)
+	if err != nil {
+		return err
+	}
+
+	err = s.repository.Delete(e)
+	if err != nil {
+		s.logger.Error("error deleting checklist item", "error", err)
+		return apperror.Internal(err)
+	}
+
+	s.refreshProgress(id)
+
+	return nil
+}
+
+// ********************* Reorder *********************
+type ReorderRequest struct {
+	ItemIDs []uint `json:"item_ids" valid:"required~item_ids_is_required" example:"3,1,2"`
+}
+
+// Reorder sets the checklist order, the request has to list every item of the task exactly once
+func (s *Service) Reorder(taskID string, req *ReorderRequest) ([]*aggregate.ItemResponse, error) {
+	id, err := s.findTask(taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.repository.FindByTaskID(id)
+	if err != nil {
+		s.logger.Error("error finding checklist items", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	if len(req.ItemIDs) != len(items) {
+		return nil, apperror.Validation("item_ids_must_contain_all_items")
+	}
+
+	existing := make(map[uint]bool)
+	for _, item := range items {
+		existing[item.ID] = true
+	}
+
+	for _, itemID := range req.ItemIDs {
+		if !existing[itemID] {
+			return nil, apperror.Validation("item_ids_must_contain_all_items")
+		}
+		// Each item may only appear once
+		delete(existing, itemID)
+	}
+
+	err = s.repository.Reorder(id, req.ItemIDs)
+	if err != nil {
+		s.logger.Error("error reordering checklist items", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return s.FindAll(taskID)
+}
+
+// Helper functions
+func (s *Service) findTask(taskID string) (uint, error) {
+	uintID, err := strconv.ParseUint(taskID, 10, 32)
+	if err != nil {
+		s.logger.Error("error parsing id", "error", err)
+		return 0, apperror.BadRequest("invalid_id")
+	}
+
+	t, err := s.taskRepository.FindByID(uint(uintID))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding task", "error", err)
+			return 0, apperror.Internal(err)
+		}
+		s.logger.Error("task not found", "error", err)
+		return 0, apperror.NotFound("task_not_found")
+	}
+
+	return t.ID, nil
+}
+
+func (s *Service) findItem(taskID uint, itemID string) (entity.Item, error) {
+	uintID, err := strconv.ParseUint(itemID, 10, 32)
+	if err != nil {
+		s.logger.Error("error parsing id", "error", err)
+		return entity.Item{}, apperror.BadRequest("invalid_id")
+	}
+
+	e, err := s.repository.FindByID(uint(uintID))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding checklist item", "error", err)
+			return entity.Item{}, apperror.Internal(err)
+		}
+		s.logger.Error("checklist item not found", "error", err)
+		return entity.Item{}, apperror.NotFound("checklist_item_not_found")
+	}
+
+	if e.TaskID != taskID {
+		s.logger.Error("checklist item belongs to another task", "item_id", e.ID, "task_id", taskID)
+		return entity.Item{}, apperror.NotFound("checklist_item_not_found")
+	}
+
+	return e, nil
+}
+
+func (s *Service) findAssignee(username *string) (*uint, string, error) {
+	if username == nil || *username == "" {
+		return nil, "", nil
+	}
+
+	user, err := s.userRepository.FindByUsername(*username)
+	if err != nil {
+		s.logger.Error("error finding user", "error", err)
+		return nil, "", apperror.Validation("can't find assignee user")
+	}
+
+	return &user.ID, user.Username, nil
+}
+
+// refreshProgress reloads the checklist of a task and stores its progress
+func (s *Service) refreshProgress(taskID uint) {
+	items, err := s.repository.FindByTaskID(taskID)
+	if err != nil {
+		s.logger.Error("error finding checklist items", "task_id", taskID, "error", err)
+		return
+	}
+
+	s.syncProgress(taskID, items)
+}
+
+// syncProgress stores the checklist progress on the task
+func (s *Service) syncProgress(taskID uint, items []entity.Item) {
+	done := 0
+	for _, item := range items {
+		if item.Done {
+			done++
+		}
+	}
+
+	_ = s.taskService.UpdateChecklistProgress(taskID, len(items), done)
+}
@@ -0,0 +1,142 @@
+package checklist
+
+import (
+	"task_mng/domain/checklist/entity"
+	"task_mng/domain/checklist/mocks"
+	projectMocks "task_mng/domain/project/mocks"
+	taskEntity "task_mng/domain/task/entity"
+	taskMocks "task_mng/domain/task/mocks"
+	userMocks "task_mng/domain/user/mocks"
+	notifierMocks "task_mng/pkg/notifier/mocks"
+	redisMocks "task_mng/pkg/redis/mocks"
+	storageMocks "task_mng/pkg/storage/mocks"
+	"task_mng/services/task"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func newTestService() (*Service, *mocks.MockChecklistRepository, *taskMocks.MockTaskRepository, *userMocks.MockUserRepository) {
+	mockRepo := new(mocks.MockChecklistRepository)
+	mockTaskRepo := new(taskMocks.MockTaskRepository)
+	mockUserRepo := new(userMocks.MockUserRepository)
+
+	// Mock CountByStatus for metrics updates of the task service
+	mockTaskRepo.On("CountByStatus").Return(map[taskEntity.Status]int64{}, nil)
+
+	taskService := task.New(mockTaskRepo, &redisMocks.MockRedisClient{}, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
+
+	return New(mockRepo, mockTaskRepo, taskService, mockUserRepo), mockRepo, mockTaskRepo, mockUserRepo
+}
+
+func TestCreate_Success(t *testing.T) {
+	service, mockRepo, mockTaskRepo, mockUserRepo := newTestService()
+
+	mockTaskRepo.On("FindByID", uint(1)).Return(taskEntity.Task{Model: gorm.Model{ID: 1}}, nil)
+	mockRepo.On("FindByTaskID", uint(1)).Return([]entity.Item{
+		{Model: gorm.Model{ID: 1}, TaskID: 1, Text: "Collect logs", Done: true, Position: 0},
+	}, nil)
+	mockRepo.On("Create", mock.MatchedBy(func(e *entity.Item) bool {
+		return e.TaskID == 1 && e.Text == "Write timeline" && e.Position == 1 && e.Assignee == nil
+	})).Return(nil)
+	mockTaskRepo.On("UpdateFields", uint(1), map[string]interface{}{
+		"checklist_total": 2,
+		"checklist_done":  1,
+	}).Return(nil)
+
+	result, err := service.Create("1", &CreateRequest{Text: "Write timeline"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Write timeline", result.Text)
+	assert.Equal(t, 1, result.Position)
+	mockRepo.AssertExpectations(t)
+	mockTaskRepo.AssertExpectations(t)
+	mockUserRepo.AssertExpectations(t)
+}
+
+func TestCreate_TaskNotFound(t *testing.T) {
+	service, mockRepo, mockTaskRepo, _ := newTestService()
+
+	mockTaskRepo.On("FindByID", uint(1)).Return(taskEntity.Task{}, gorm.ErrRecordNotFound)
+
+	result, err := service.Create("1", &CreateRequest{Text: "Write timeline"})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "task_not_found", err.Error())
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestUpdate_MarksDone(t *testing.T) {
+	service, mockRepo, mockTaskRepo, _ := newTestService()
+
+	mockTaskRepo.On("FindByID", uint(1)).Return(taskEntity.Task{Model: gorm.Model{ID: 1}}, nil)
+	mockRepo.On("FindByID", uint(2)).Return(entity.Item{Model: gorm.Model{ID: 2}, TaskID: 1, Text: "Collect logs"}, nil)
+	mockRepo.On("Update", mock.MatchedBy(func(e entity.Item) bool {
+		return e.ID == 2 && e.Done
+	})).Return(nil)
+	mockRepo.On("FindByTaskID", uint(1)).Return([]entity.Item{
+		{Model: gorm.Model{ID: 2}, TaskID: 1, Done: true},
+		{Model: gorm.Model{ID: 3}, TaskID: 1},
+	}, nil)
+	mockTaskRepo.On("UpdateFields", uint(1), map[string]interface{}{
+		"checklist_total": 2,
+		"checklist_done":  1,
+	}).Return(nil)
+
+	result, err := service.Update("1", "2", &UpdateRequest{Text: "Collect logs", Done: true})
+
+	assert.NoError(t, err)
+	assert.True(t, result.Done)
+	mockRepo.AssertExpectations(t)
+	mockTaskRepo.AssertExpectations(t)
+}
+
+func TestUpdate_ItemOfAnotherTask(t *testing.T) {
+	service, mockRepo, mockTaskRepo, _ := newTestService()
+
+	mockTaskRepo.On("FindByID", uint(1)).Return(taskEntity.Task{Model: gorm.Model{ID: 1}}, nil)
+	mockRepo.On("FindByID", uint(2)).Return(entity.Item{Model: gorm.Model{ID: 2}, TaskID: 5}, nil)
+
+	result, err := service.Update("1", "2", &UpdateRequest{Text: "Collect logs"})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "checklist_item_not_found", err.Error())
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestReorder_MissingItem(t *testing.T) {
+	service, mockRepo, mockTaskRepo, _ := newTestService()
+
+	mockTaskRepo.On("FindByID", uint(1)).Return(taskEntity.Task{Model: gorm.Model{ID: 1}}, nil)
+	mockRepo.On("FindByTaskID", uint(1)).Return([]entity.Item{
+		{Model: gorm.Model{ID: 1}, TaskID: 1},
+		{Model: gorm.Model{ID: 2}, TaskID: 1},
+	}, nil)
+
+	result, err := service.Reorder("1", &ReorderRequest{ItemIDs: []uint{2, 2}})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "item_ids_must_contain_all_items", err.Error())
+	mockRepo.AssertNotCalled(t, "Reorder", mock.Anything, mock.Anything)
+}
+
+func TestReorder_Success(t *testing.T) {
+	service, mockRepo, mockTaskRepo, _ := newTestService()
+
+	mockTaskRepo.On("FindByID", uint(1)).Return(taskEntity.Task{Model: gorm.Model{ID: 1}}, nil)
+	mockRepo.On("FindByTaskID", uint(1)).Return([]entity.Item{
+		{Model: gorm.Model{ID: 1}, TaskID: 1},
+		{Model: gorm.Model{ID: 2}, TaskID: 1},
+	}, nil)
+	mockRepo.On("Reorder", uint(1), []uint{2, 1}).Return(nil)
+
+	_, err := service.Reorder("1", &ReorderRequest{ItemIDs: []uint{2, 1}})
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
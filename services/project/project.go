@@ -0,0 +1,297 @@
+package project
+
+import (
+	"errors"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"task_mng/domain/project"
+	"task_mng/domain/project/aggregate"
+	"task_mng/domain/project/entity"
+	"task_mng/pkg/apperror"
+
+	"gorm.io/gorm"
+)
+
+// fieldKeyPattern is the format of custom field keys, they are used as JSON keys and query parameters
+var fieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+type Service struct {
+	repository project.Repository
+	logger     *slog.Logger
+}
+
+func New(repository project.Repository) *Service {
+	return &Service{repository: repository, logger: slog.Default()}
+}
+
+// ********************* Create *********************
+type CreateRequest struct {
+	Name        string `json:"name" valid:"required~name_is_required" example:"Platform"`
+	Description string `json:"description" example:"Platform team work"`
+}
+
+func (s *Service) Create(req *CreateRequest) (*aggregate.ProjectResponse, error) {
+	if err := s.checkNameAvailable(req.Name, 0); err != nil {
+		return nil, err
+	}
+
+	e := &entity.Project{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	err := s.repository.Create(e)
+	if err != nil {
+		s.logger.Error("error creating project", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewProjectResponse(e), nil
+}
+
+// ********************* Update *********************
+type UpdateRequest struct {
+	Name        string `json:"name" valid:"required~name_is_required" example:"Platform"`
+	Description string `json:"description" example:"Platform team work"`
+}
+
+func (s *Service) Update(req *UpdateRequest, id string) (*aggregate.ProjectResponse, error) {
+	e, err := s.findByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkNameAvailable(req.Name, e.ID); err != nil {
+		return nil, err
+	}
+
+	e.Name = req.Name
+	e.Description = req.Description
+
+	err = s.repository.Update(e)
+	if err != nil {
+		s.logger.Error("error updating project", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewProjectResponse(&e), nil
+}
+
+// ********************* Find By ID *********************
+func (s *Service) FindByID(id string) (*aggregate.ProjectResponse, error) {
+	e, err := s.findByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggregate.NewProjectResponse(&e), nil
+}
+
+// ********************* Find All *********************
+func (s *Service) FindAll(page, limit int) (*aggregate.ProjectListResponse, error) {
+	projects, count, err := s.repository.FindAll(page, limit)
+	if err != nil {
+		s.logger.Error("error finding projects", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewProjectListResponse(projects, page, limit, count, ""), nil
+}
+
+// ********************* Custom Fields *********************
+type CreateFieldRequest struct {
+	Key      string           `json:"key" valid:"required~key_is_required" example:"environment"`
+	Name     string           `json:"name" valid:"required~name_is_required" example:"Environment"`
+	Type     entity.FieldType `json:"type" valid:"required~type_is_required" example:"select"`
+	Options  []string         `json:"options" example:"dev,staging,prod"`
+	Required bool             `json:"required" example:"false"`
+}
+
+func (s *Service) FindFields(id string) ([]*aggregate.FieldResponse, error) {
+	e, err := s.findByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := s.repository.FindFields(e.ID)
+	if err != nil {
+		s.logger.Error("error finding project fields", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewFieldListResponse(fields), nil
+}
+
+func (s *Service) CreateField(id string, req *CreateFieldRequest) (*aggregate.FieldResponse, error) {
+	e, err := s.findByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !fieldKeyPattern.MatchString(req.Key) {
+		return nil, apperror.Validation("invalid_field_key")
+	}
+
+	if !entity.IsValidFieldType(req.Type) {
+		return nil, apperror.Validation("invalid_field_type")
+	}
+
+	if err := validateOptions(req.Type, req.Options); err != nil {
+		return nil, err
+	}
+
+	fields, err := s.repository.FindFields(e.ID)
+	if err != nil {
+		s.logger.Error("error finding project fields", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	for _, f := range fields {
+		if f.Key == req.Key {
+			return nil, apperror.Conflict("field_key_already_exists")
+		}
+	}
+
+	field := &entity.Field{
+		ProjectID: e.ID,
+		Key:       req.Key,
+		Name:      req.Name,
+		Type:      req.Type,
+		Options:   req.Options,
+		Required:  req.Required,
+	}
+
+	err = s.repository.CreateField(field)
+	if err != nil {
+		s.logger.Error("error creating project field", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewFieldResponse(field), nil
+}
+
+// UpdateFieldRequest changes a field definition, the key and type are fixed once values may be stored
+type UpdateFieldRequest struct {
+	Name     string   `json:"name" valid:"required~name_is_required" example:"Environment"`
+	Options  []string `json:"options" example:"dev,staging,prod"`
+	Required bool     `json:"required" example:"false"`
+}
+
+func (s *Service) UpdateField(id, fieldID string, req *UpdateFieldRequest) (*aggregate.FieldResponse, error) {
+	field, err := s.findField(id, fieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateOptions(field.Type, req.Options); err != nil {
+		return nil, err
+	}
+
+	field.Name = req.Name
+	field.Options = req.Options
+	field.Required = req.Required
+
+	err = s.repository.UpdateField(field)
+	if err != nil {
+		s.logger.Error("error updating project field", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewFieldResponse(&field), nil
+}
+
+// DeleteField removes a field definition, values already stored on tasks are kept but no longer validated
+func (s *Service) DeleteField(id, fieldID string) error {
+	field, err := s.findField(id, fieldID)
+	if err != nil {
+		return err
+	}
+
+	err = s.repository.DeleteField(field)
+	if err != nil {
+		s.logger.Error("error deleting project field", "error", err)
+		return apperror.Internal(err)
+	}
+
+	return nil
+}
+
+// Helper functions
+func (s *Service) findByID(id string) (entity.Project, error) {
+	uintID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		s.logger.Error("error parsing id", "error", err)
+		return entity.Project{}, apperror.BadRequest("invalid_id")
+	}
+
+	e, err := s.repository.FindByID(uint(uintID))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding project", "error", err)
+			return entity.Project{}, apperror.Internal(err)
+		}
+		s.logger.Error("project not found", "error", err)
+		return entity.Project{}, apperror.NotFound("project_not_found")
+	}
+
+	return e, nil
+}
+
+func (s *Service) findField(id, fieldID string) (entity.Field, error) {
+	e, err := s.findByID(id)
+	if err != nil {
+		return entity.Field{}, err
+	}
+
+	uintID, err := strconv.ParseUint(fieldID, 10, 32)
+	if err != nil {
+		s.logger.Error("error parsing field id", "error", err)
+		return entity.Field{}, apperror.BadRequest("invalid_field_id")
+	}
+
+	field, err := s.repository.FindField(e.ID, uint(uintID))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding project field", "error", err)
+			return entity.Field{}, apperror.Internal(err)
+		}
+		s.logger.Error("project field not found", "error", err)
+		return entity.Field{}, apperror.NotFound("field_not_found")
+	}
+
+	return field, nil
+}
+
+// checkNameAvailable fails when another project than exceptID already uses the name
+func (s *Service) checkNameAvailable(name string, exceptID uint) error {
+	existing, err := s.repository.FindByName(name)
+	if err == nil && existing.ID != exceptID {
+		return apperror.Conflict("project_name_already_exists")
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.logger.Error("error finding project", "error", err)
+		return apperror.Internal(err)
+	}
+	return nil
+}
+
+// validateOptions requires options for select fields and rejects them for the other types
+func validateOptions(t entity.FieldType, options []string) error {
+	selectType := t == entity.FieldTypeSelect || t == entity.FieldTypeMultiSelect
+	if selectType && len(options) == 0 {
+		return apperror.Validation("field_options_required")
+	}
+	if !selectType && len(options) > 0 {
+		return apperror.Validation("field_options_not_allowed")
+	}
+
+	seen := make(map[string]bool)
+	for _, option := range options {
+		if option == "" || seen[option] {
+			return apperror.Validation("invalid_field_options")
+		}
+		seen[option] = true
+	}
+	return nil
+}
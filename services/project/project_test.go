@@ -0,0 +1,77 @@
+package project
+
+import (
+	"task_mng/domain/project/entity"
+	"task_mng/domain/project/mocks"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestCreate_NameTaken(t *testing.T) {
+	mockRepo := new(mocks.MockProjectRepository)
+	service := New(mockRepo)
+
+	mockRepo.On("FindByName", "Platform").Return(entity.Project{Model: gorm.Model{ID: 1}, Name: "Platform"}, nil)
+
+	result, err := service.Create(&CreateRequest{Name: "Platform"})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "project_name_already_exists", err.Error())
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestCreateField_Success(t *testing.T) {
+	mockRepo := new(mocks.MockProjectRepository)
+	service := New(mockRepo)
+
+	mockRepo.On("FindByID", uint(1)).Return(entity.Project{Model: gorm.Model{ID: 1}}, nil)
+	mockRepo.On("FindFields", uint(1)).Return([]entity.Field{{ID: 1, ProjectID: 1, Key: "customer", Type: entity.FieldTypeText}}, nil)
+	mockRepo.On("CreateField", mock.MatchedBy(func(e *entity.Field) bool {
+		return e.ProjectID == 1 && e.Key == "environment" && e.Type == entity.FieldTypeSelect && len(e.Options) == 2
+	})).Return(nil)
+
+	result, err := service.CreateField("1", &CreateFieldRequest{
+		Key:     "environment",
+		Name:    "Environment",
+		Type:    entity.FieldTypeSelect,
+		Options: []string{"staging", "prod"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "environment", result.Key)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateField_Invalid(t *testing.T) {
+	mockRepo := new(mocks.MockProjectRepository)
+	service := New(mockRepo)
+
+	mockRepo.On("FindByID", uint(1)).Return(entity.Project{Model: gorm.Model{ID: 1}}, nil)
+	mockRepo.On("FindFields", uint(1)).Return([]entity.Field{{ID: 1, ProjectID: 1, Key: "customer", Type: entity.FieldTypeText}}, nil)
+
+	tests := []struct {
+		name string
+		req  CreateFieldRequest
+		want string
+	}{
+		{"bad key", CreateFieldRequest{Key: "Story Points", Name: "Points", Type: entity.FieldTypeNumber}, "invalid_field_key"},
+		{"bad type", CreateFieldRequest{Key: "points", Name: "Points", Type: "money"}, "invalid_field_type"},
+		{"select without options", CreateFieldRequest{Key: "env", Name: "Env", Type: entity.FieldTypeSelect}, "field_options_required"},
+		{"options on text", CreateFieldRequest{Key: "env", Name: "Env", Type: entity.FieldTypeText, Options: []string{"a"}}, "field_options_not_allowed"},
+		{"duplicate key", CreateFieldRequest{Key: "customer", Name: "Customer", Type: entity.FieldTypeText}, "field_key_already_exists"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.CreateField("1", &tt.req)
+			assert.Error(t, err)
+			assert.Equal(t, tt.want, err.Error())
+		})
+	}
+
+	mockRepo.AssertNotCalled(t, "CreateField", mock.Anything)
+}
@@ -0,0 +1,273 @@
+package recurrence
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+	"task_mng/domain/recurrence"
+	"task_mng/domain/recurrence/aggregate"
+	"task_mng/domain/recurrence/entity"
+	taskR "task_mng/domain/task"
+	taskEntity "task_mng/domain/task/entity"
+	"task_mng/domain/user"
+	"task_mng/pkg/apperror"
+	"task_mng/services/task"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Service struct {
+	repository     recurrence.Repository
+	logger         *slog.Logger
+	taskRepository taskR.Repository
+	taskService    *task.Service
+	userRepository user.Repository
+}
+
+func New(repository recurrence.Repository, taskRepository taskR.Repository, taskService *task.Service, userRepository user.Repository) *Service {
+	return &Service{
+		repository:     repository,
+		logger:         slog.Default(),
+		taskRepository: taskRepository,
+		taskService:    taskService,
+		userRepository: userRepository,
+	}
+}
+
+// ********************* Create *********************
+type CreateRequest struct {
+	Summary     string               `json:"summary" valid:"required~summary_is_required" example:"Weekly ops checklist"`
+	Description string               `json:"description" example:"Go through the weekly ops checklist"`
+	Assignee    string               `json:"assignee" valid:"required~assignee_is_required" example:"admin"`
+	Priority    *taskEntity.Priority `json:"priority" valid:"optional,in(lowest|low|medium|high|highest)~invalid_priority" example:"medium"`
+	DueInHours  int                  `json:"due_in_hours" valid:"range(0|8760)~invalid_due_in_hours" example:"24"`
+	Frequency   entity.Frequency     `json:"frequency" valid:"required~frequency_is_required,in(daily|weekly|monthly)~invalid_frequency" example:"weekly"`
+	Interval    int                  `json:"interval" valid:"range(0|365)~invalid_interval" example:"1"`
+	ByWeekday   []string             `json:"by_weekday" example:"MO"`
+	StartAt     *time.Time           `json:"start_at" example:"2025-01-06T09:00:00Z"`
+	Until       *time.Time           `json:"until" example:"2025-12-31T00:00:00Z"`
+	Count       int                  `json:"count" valid:"range(0|10000)~invalid_count" example:"0"`
+	Mode        *entity.Mode         `json:"mode" valid:"optional,in(schedule|completion)~invalid_mode" example:"schedule"`
+}
+
+func (s *Service) Create(req *CreateRequest) (*aggregate.RecurrenceResponse, error) {
+	byWeekday, err := entity.ParseWeekdays(req.ByWeekday)
+	if err != nil {
+		s.logger.Error("error parsing weekdays", "error", err)
+		return nil, apperror.Validation("invalid_by_weekday")
+	}
+
+	if byWeekday != "" && req.Frequency != entity.FrequencyWeekly {
+		return nil, apperror.Validation("by_weekday_requires_weekly_frequency")
+	}
+
+	startAt := time.Now().UTC()
+	if req.StartAt != nil {
+		startAt = req.StartAt.UTC()
+	}
+
+	if req.Until != nil && req.Until.Before(startAt) {
+		return nil, apperror.Validation("until_must_be_after_start_at")
+	}
+
+	interval := 1
+	if req.Interval > 0 {
+		interval = req.Interval
+	}
+
+	priority := taskEntity.PriorityMedium
+	if req.Priority != nil {
+		priority = *req.Priority
+	}
+
+	mode := entity.ModeSchedule
+	if req.Mode != nil {
+		mode = *req.Mode
+	}
+
+	assignee, err := s.userRepository.FindByUsername(req.Assignee)
+	if err != nil {
+		s.logger.Error("error finding user", "error", err)
+		return nil, apperror.Validation("can't find assignee user")
+	}
+
+	e := &entity.Recurrence{
+		Summary:     req.Summary,
+		Description: req.Description,
+		Assignee:    assignee.ID,
+		Priority:    priority,
+		DueInHours:  req.DueInHours,
+		Frequency:   req.Frequency,
+		Interval:    interval,
+		ByWeekday:   byWeekday,
+		StartAt:     startAt,
+		Until:       req.Until,
+		Count:       req.Count,
+		Mode:        mode,
+	}
+
+	// The start itself is the first occurrence when it matches the rule
+	if next, ok := e.Next(startAt.Add(-time.Nanosecond)); ok {
+		e.NextRunAt = &next
+	}
+
+	err = s.repository.Create(e)
+	if err != nil {
+		s.logger.Error("error creating recurrence", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewRecurrenceResponse(e), nil
+}
+
+// ********************* Find By ID *********************
+func (s *Service) FindByID(id string) (*aggregate.RecurrenceResponse, error) {
+	e, err := s.findByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggregate.NewRecurrenceResponse(&e), nil
+}
+
+// ********************* Find All *********************
+func (s *Service) FindAll(page, limit int) (*aggregate.RecurrenceListResponse, error) {
+	recurrences, count, err := s.repository.FindAll(page, limit)
+	if err != nil {
+		s.logger.Error("error finding recurrences", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewRecurrenceListResponse(recurrences, page, limit, count, ""), nil
+}
+
+// ********************* Delete *********************
+func (s *Service) Delete(id string) error {
+	e, err := s.findByID(id)
+	if err != nil {
+		return err
+	}
+
+	err = s.repository.Delete(e)
+	if err != nil {
+		s.logger.Error("error deleting recurrence", "error", err)
+		return apperror.Internal(err)
+	}
+
+	return nil
+}
+
+// ********************* Generate Occurrences *********************
+
+// Generate creates the next task occurrence of every due rule
+// Rules in completion mode wait until the previously generated task is done
+func (s *Service) Generate(ctx context.Context) error {
+	now := time.Now().UTC()
+
+	recurrences, err := s.repository.FindDue(now)
+	if err != nil {
+		s.logger.Error("error finding due recurrences", "error", err)
+		return err
+	}
+
+	for _, e := range recurrences {
+		if e.Mode == entity.ModeCompletion && !s.previousDone(e) {
+			continue
+		}
+
+		if err := s.generate(e, now); err != nil {
+			s.logger.Error("error generating task occurrence", "recurrence_id", e.ID, "error", err)
+		}
+	}
+
+	return nil
+}
+
+// generate creates the task for the current occurrence and advances the rule
+func (s *Service) generate(e entity.Recurrence, now time.Time) error {
+	occurrence := *e.NextRunAt
+
+	dueDate := time.Time{}
+	if e.DueInHours > 0 {
+		dueDate = occurrence.Add(time.Duration(e.DueInHours) * time.Hour)
+	}
+
+	recurrenceID := e.ID
+	t := &taskEntity.Task{
+		Summary:      e.Summary,
+		Description:  e.Description,
+		Assignee:     e.Assignee,
+		Priority:     e.Priority,
+		DueDate:      dueDate,
+		RecurrenceID: &recurrenceID,
+	}
+
+	err := s.taskService.CreateOccurrence(t)
+	if err != nil {
+		return err
+	}
+
+	e.Occurrences++
+	e.LastTaskID = &t.ID
+
+	// A completed occurrence may be late, the next one should not be in the past
+	after := occurrence
+	if e.Mode == entity.ModeCompletion && now.After(after) {
+		after = now
+	}
+
+	e.NextRunAt = nil
+	if next, ok := e.Next(after); ok {
+		e.NextRunAt = &next
+	}
+
+	err = s.repository.Update(e)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("Task occurrence generated", "recurrence_id", e.ID, "task_id", t.ID, "next_run_at", e.NextRunAt)
+
+	return nil
+}
+
+// previousDone reports whether the last generated task is done or no longer exists
+func (s *Service) previousDone(e entity.Recurrence) bool {
+	if e.LastTaskID == nil {
+		return true
+	}
+
+	t, err := s.taskRepository.FindByID(*e.LastTaskID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return true
+		}
+		s.logger.Error("error finding previous occurrence", "task_id", *e.LastTaskID, "error", err)
+		return false
+	}
+
+	return t.Status == taskEntity.StatusDone
+}
+
+// Helper functions
+func (s *Service) findByID(id string) (entity.Recurrence, error) {
+	uintID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		s.logger.Error("error parsing id", "error", err)
+		return entity.Recurrence{}, apperror.BadRequest("invalid_id")
+	}
+
+	e, err := s.repository.FindByID(uint(uintID))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding recurrence", "error", err)
+			return entity.Recurrence{}, apperror.Internal(err)
+		}
+		s.logger.Error("recurrence not found", "error", err)
+		return entity.Recurrence{}, apperror.NotFound("recurrence_not_found")
+	}
+
+	return e, nil
+}
@@ -0,0 +1,161 @@
+package recurrence
+
+import (
+	"context"
+	projectMocks "task_mng/domain/project/mocks"
+	"task_mng/domain/recurrence/entity"
+	"task_mng/domain/recurrence/mocks"
+	taskEntity "task_mng/domain/task/entity"
+	taskMocks "task_mng/domain/task/mocks"
+	userEntity "task_mng/domain/user/entity"
+	userMocks "task_mng/domain/user/mocks"
+	notifierMocks "task_mng/pkg/notifier/mocks"
+	redisMocks "task_mng/pkg/redis/mocks"
+	storageMocks "task_mng/pkg/storage/mocks"
+	"task_mng/services/task"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func newTestService() (*Service, *mocks.MockRecurrenceRepository, *taskMocks.MockTaskRepository, *userMocks.MockUserRepository) {
+	mockRepo := new(mocks.MockRecurrenceRepository)
+	mockTaskRepo := new(taskMocks.MockTaskRepository)
+	mockUserRepo := new(userMocks.MockUserRepository)
+
+	// Mock CountByStatus for metrics updates of the task service
+	mockTaskRepo.On("CountByStatus").Return(map[taskEntity.Status]int64{}, nil)
+
+	taskService := task.New(mockTaskRepo, &redisMocks.MockRedisClient{}, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
+
+	return New(mockRepo, mockTaskRepo, taskService, mockUserRepo), mockRepo, mockTaskRepo, mockUserRepo
+}
+
+func TestCreate_Success(t *testing.T) {
+	service, mockRepo, _, mockUserRepo := newTestService()
+
+	startAt := time.Date(2025, time.January, 1, 9, 0, 0, 0, time.UTC) // Wednesday
+	req := &CreateRequest{
+		Summary:   "Ops checklist",
+		Assignee:  "admin",
+		Frequency: entity.FrequencyWeekly,
+		ByWeekday: []string{"MO"},
+		StartAt:   &startAt,
+	}
+
+	mockUserRepo.On("FindByUsername", "admin").Return(userEntity.User{
+		Model:    gorm.Model{ID: 1},
+		Username: "admin",
+	}, nil)
+
+	mockRepo.On("Create", mock.MatchedBy(func(e *entity.Recurrence) bool {
+		return e.Assignee == 1 &&
+			e.Interval == 1 &&
+			e.ByWeekday == "MO" &&
+			e.Mode == entity.ModeSchedule &&
+			e.Priority == taskEntity.PriorityMedium &&
+			e.NextRunAt != nil &&
+			e.NextRunAt.Equal(time.Date(2025, time.January, 6, 9, 0, 0, 0, time.UTC))
+	})).Return(nil)
+
+	resp, err := service.Create(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"MO"}, resp.ByWeekday)
+	mockRepo.AssertExpectations(t)
+	mockUserRepo.AssertExpectations(t)
+}
+
+func TestCreate_InvalidWeekday(t *testing.T) {
+	service, mockRepo, _, mockUserRepo := newTestService()
+
+	_, err := service.Create(&CreateRequest{
+		Summary:   "Ops checklist",
+		Assignee:  "admin",
+		Frequency: entity.FrequencyWeekly,
+		ByWeekday: []string{"XX"},
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, "invalid_by_weekday", err.Error())
+	mockRepo.AssertExpectations(t)
+	mockUserRepo.AssertExpectations(t)
+}
+
+func TestGenerate_Schedule(t *testing.T) {
+	service, mockRepo, mockTaskRepo, _ := newTestService()
+
+	nextRunAt := time.Now().UTC().Add(-time.Minute)
+	mockRepo.On("FindDue", mock.Anything).Return([]entity.Recurrence{
+		{
+			Model:      gorm.Model{ID: 3},
+			Summary:    "Ops checklist",
+			Assignee:   1,
+			Priority:   taskEntity.PriorityHigh,
+			DueInHours: 24,
+			Frequency:  entity.FrequencyDaily,
+			Interval:   1,
+			StartAt:    nextRunAt,
+			Mode:       entity.ModeSchedule,
+			NextRunAt:  &nextRunAt,
+		},
+	}, nil)
+
+	mockTaskRepo.On("Create", mock.MatchedBy(func(t *taskEntity.Task) bool {
+		return t.Summary == "Ops checklist" &&
+			t.Status == taskEntity.StatusTodo &&
+			t.Priority == taskEntity.PriorityHigh &&
+			t.RecurrenceID != nil && *t.RecurrenceID == 3 &&
+			t.DueDate.Equal(nextRunAt.Add(24*time.Hour))
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*taskEntity.Task).ID = 10
+	}).Return(nil)
+	mockTaskRepo.On("AddWatchers", uint(10), mock.Anything).Return(nil)
+
+	mockRepo.On("Update", mock.MatchedBy(func(e entity.Recurrence) bool {
+		return e.Occurrences == 1 &&
+			e.LastTaskID != nil && *e.LastTaskID == 10 &&
+			e.NextRunAt != nil && e.NextRunAt.Equal(nextRunAt.AddDate(0, 0, 1))
+	})).Return(nil)
+
+	err := service.Generate(context.Background())
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockTaskRepo.AssertExpectations(t)
+}
+
+func TestGenerate_CompletionWaitsForPrevious(t *testing.T) {
+	service, mockRepo, mockTaskRepo, _ := newTestService()
+
+	nextRunAt := time.Now().UTC().Add(-time.Minute)
+	lastTaskID := uint(10)
+	mockRepo.On("FindDue", mock.Anything).Return([]entity.Recurrence{
+		{
+			Model:      gorm.Model{ID: 3},
+			Summary:    "Ops checklist",
+			Assignee:   1,
+			Frequency:  entity.FrequencyDaily,
+			Interval:   1,
+			StartAt:    nextRunAt,
+			Mode:       entity.ModeCompletion,
+			NextRunAt:  &nextRunAt,
+			LastTaskID: &lastTaskID,
+		},
+	}, nil)
+
+	mockTaskRepo.On("FindByID", lastTaskID).Return(taskEntity.Task{
+		Model:  gorm.Model{ID: lastTaskID},
+		Status: taskEntity.StatusInProgress,
+	}, nil)
+
+	err := service.Generate(context.Background())
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockTaskRepo.AssertExpectations(t)
+	mockTaskRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
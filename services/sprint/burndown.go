@@ -0,0 +1,82 @@
+package sprint
+
+import (
+	"task_mng/domain/sprint/aggregate"
+	"task_mng/domain/sprint/entity"
+	taskEntity "task_mng/domain/task/entity"
+	"time"
+)
+
+const day = 24 * time.Hour
+
+// burndown computes the remaining tasks and estimate at the end of each day from the sprint start until end
+// history holds the status changes of the tasks in chronological order
+func burndown(sprint entity.Sprint, end time.Time, tasks []taskEntity.Task, history []taskEntity.History) *aggregate.BurndownResponse {
+	byTask := make(map[uint][]taskEntity.History)
+	for _, entry := range history {
+		byTask[entry.TaskID] = append(byTask[entry.TaskID], entry)
+	}
+
+	resp := &aggregate.BurndownResponse{
+		SprintID:   sprint.ID,
+		TotalTasks: len(tasks),
+		Points:     make([]aggregate.BurndownPoint, 0),
+	}
+	for _, t := range tasks {
+		resp.TotalEstimate += t.OriginalEstimate
+	}
+
+	start := truncateDay(sprint.StartDate)
+	days := int(truncateDay(sprint.EndDate).Sub(start)/day) + 1
+
+	for i := 0; i < days; i++ {
+		dayStart := start.Add(time.Duration(i) * day)
+		if dayStart.After(end) {
+			break
+		}
+		dayEnd := dayStart.Add(day)
+
+		point := aggregate.BurndownPoint{
+			Date:       dayStart.Format("2006-01-02"),
+			IdealTasks: idealRemaining(len(tasks), i, days),
+		}
+
+		for _, t := range tasks {
+			if statusAt(t, byTask[t.ID], dayEnd) != taskEntity.StatusDone {
+				point.RemainingTasks++
+				point.RemainingEstimate += t.OriginalEstimate
+			}
+		}
+
+		resp.Points = append(resp.Points, point)
+	}
+
+	return resp
+}
+
+// statusAt returns the status the task had at the given time
+func statusAt(t taskEntity.Task, history []taskEntity.History, at time.Time) taskEntity.Status {
+	// A task without recorded changes kept its status all along
+	if len(history) == 0 {
+		return t.Status
+	}
+
+	status := taskEntity.Status(history[0].OldValue)
+	for _, entry := range history {
+		if !entry.CreatedAt.Before(at) {
+			break
+		}
+		status = taskEntity.Status(entry.NewValue)
+	}
+
+	return status
+}
+
+// idealRemaining is the linear burndown from total at the start of the sprint to zero at the end of its last day
+func idealRemaining(total, dayIndex, days int) float64 {
+	return float64(total) * float64(days-dayIndex-1) / float64(days)
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,63 @@
+package sprint
+
+import (
+	"task_mng/domain/sprint/entity"
+	taskEntity "task_mng/domain/task/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func at(d, h int) time.Time {
+	return time.Date(2025, time.January, d, h, 0, 0, 0, time.UTC)
+}
+
+func TestBurndown(t *testing.T) {
+	sprint := entity.Sprint{Model: gorm.Model{ID: 1}, StartDate: at(6, 9), EndDate: at(9, 18)}
+
+	tasks := []taskEntity.Task{
+		{Model: gorm.Model{ID: 1}, Status: taskEntity.StatusDone, OriginalEstimate: 60},
+		{Model: gorm.Model{ID: 2}, Status: taskEntity.StatusTodo, OriginalEstimate: 120},
+		{Model: gorm.Model{ID: 3}, Status: taskEntity.StatusInProgress, OriginalEstimate: 30},
+	}
+
+	history := []taskEntity.History{
+		{TaskID: 1, OldValue: "ToDo", NewValue: "InProgress", CreatedAt: at(6, 10)},
+		{TaskID: 1, OldValue: "InProgress", NewValue: "Done", CreatedAt: at(7, 11)},
+		// Task 2 was done on day 2 and reopened on day 3
+		{TaskID: 2, OldValue: "ToDo", NewValue: "Done", CreatedAt: at(7, 15)},
+		{TaskID: 2, OldValue: "Done", NewValue: "ToDo", CreatedAt: at(8, 9)},
+	}
+
+	// The sprint is still running on its third day
+	result := burndown(sprint, at(8, 12), tasks, history)
+
+	assert.Equal(t, 3, result.TotalTasks)
+	assert.Equal(t, 210, result.TotalEstimate)
+	require.Len(t, result.Points, 3)
+
+	assert.Equal(t, "2025-01-06", result.Points[0].Date)
+	assert.Equal(t, 3, result.Points[0].RemainingTasks)
+	assert.Equal(t, 210, result.Points[0].RemainingEstimate)
+	assert.InDelta(t, 2.25, result.Points[0].IdealTasks, 0.001)
+
+	assert.Equal(t, 1, result.Points[1].RemainingTasks)
+	assert.Equal(t, 30, result.Points[1].RemainingEstimate)
+
+	assert.Equal(t, 2, result.Points[2].RemainingTasks)
+	assert.Equal(t, 150, result.Points[2].RemainingEstimate)
+}
+
+func TestBurndown_NoTasks(t *testing.T) {
+	sprint := entity.Sprint{Model: gorm.Model{ID: 1}, StartDate: at(6, 9), EndDate: at(7, 18)}
+
+	result := burndown(sprint, at(20, 0), nil, nil)
+
+	assert.Equal(t, 0, result.TotalTasks)
+	require.Len(t, result.Points, 2)
+	assert.Equal(t, 0, result.Points[1].RemainingTasks)
+	assert.Equal(t, 0.0, result.Points[1].IdealTasks)
+}
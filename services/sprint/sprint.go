@@ -0,0 +1,411 @@
+package sprint
+
+import (
+	"errors"
+	"log/slog"
+	"strconv"
+	"task_mng/domain/sprint"
+	"task_mng/domain/sprint/aggregate"
+	"task_mng/domain/sprint/entity"
+	taskR "task_mng/domain/task"
+	taskAggregate "task_mng/domain/task/aggregate"
+	taskEntity "task_mng/domain/task/entity"
+	"task_mng/domain/user"
+	"task_mng/pkg/apperror"
+	"task_mng/services/task"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Service struct {
+	repository     sprint.Repository
+	logger         *slog.Logger
+	taskRepository taskR.Repository
+	taskService    *task.Service
+	userRepository user.Repository
+	now            func() time.Time
+}
+
+func New(repository sprint.Repository, taskRepository taskR.Repository, taskService *task.Service, userRepository user.Repository) *Service {
+	return &Service{
+		repository:     repository,
+		logger:         slog.Default(),
+		taskRepository: taskRepository,
+		taskService:    taskService,
+		userRepository: userRepository,
+		now:            time.Now,
+	}
+}
+
+// ********************* Create *********************
+type CreateRequest struct {
+	Name      string    `json:"name" valid:"required~name_is_required" example:"Sprint 12"`
+	Goal      string    `json:"goal" example:"Ship the reporting module"`
+	StartDate time.Time `json:"start_date" valid:"required~start_date_is_required" example:"2025-01-06T00:00:00Z"`
+	EndDate   time.Time `json:"end_date" valid:"required~end_date_is_required" example:"2025-01-19T23:59:59Z"`
+}
+
+func (s *Service) Create(req *CreateRequest) (*aggregate.SprintResponse, error) {
+	if !req.EndDate.After(req.StartDate) {
+		return nil, apperror.Validation("end_date_must_be_after_start_date")
+	}
+
+	e := &entity.Sprint{
+		Name:      req.Name,
+		Goal:      req.Goal,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		State:     entity.StatePlanned,
+	}
+
+	err := s.repository.Create(e)
+	if err != nil {
+		s.logger.Error("error creating sprint", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewSprintResponse(e), nil
+}
+
+// ********************* Update *********************
+type UpdateRequest struct {
+	Name      string    `json:"name" valid:"required~name_is_required" example:"Sprint 12"`
+	Goal      string    `json:"goal" example:"Ship the reporting module"`
+	StartDate time.Time `json:"start_date" valid:"required~start_date_is_required" example:"2025-01-06T00:00:00Z"`
+	EndDate   time.Time `json:"end_date" valid:"required~end_date_is_required" example:"2025-01-19T23:59:59Z"`
+}
+
+func (s *Service) Update(req *UpdateRequest, id string) (*aggregate.SprintResponse, error) {
+	e, err := s.findByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.State == entity.StateCompleted {
+		return nil, apperror.Conflict("sprint_is_completed")
+	}
+
+	if !req.EndDate.After(req.StartDate) {
+		return nil, apperror.Validation("end_date_must_be_after_start_date")
+	}
+
+	e.Name = req.Name
+	e.Goal = req.Goal
+	e.StartDate = req.StartDate
+	e.EndDate = req.EndDate
+
+	err = s.repository.Update(e)
+	if err != nil {
+		s.logger.Error("error updating sprint", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewSprintResponse(&e), nil
+}
+
+// ********************* Find By ID *********************
+func (s *Service) FindByID(id string) (*aggregate.SprintResponse, error) {
+	e, err := s.findByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggregate.NewSprintResponse(&e), nil
+}
+
+// ********************* Find All *********************
+func (s *Service) FindAll(page, limit int) (*aggregate.SprintListResponse, error) {
+	sprints, count, err := s.repository.FindAll(page, limit)
+	if err != nil {
+		s.logger.Error("error finding sprints", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewSprintListResponse(sprints, page, limit, count, ""), nil
+}
+
+// ********************* Tasks *********************
+type TasksRequest struct {
+	TaskIDs []uint `json:"task_ids" valid:"required~task_ids_is_required" example:"1,2,3"`
+}
+
+// FindTasks returns the tasks currently assigned to the sprint
+func (s *Service) FindTasks(id string) ([]*taskAggregate.TaskResponse, error) {
+	e, err := s.findByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.taskRepository.FindBySprintID(e.ID)
+	if err != nil {
+		s.logger.Error("error finding sprint tasks", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	usernames := make(map[uint]string)
+	if userIDs := taskAggregate.UserIDs(tasks); len(userIDs) > 0 {
+		users, err := s.userRepository.FindByIDs(userIDs)
+		if err != nil {
+			s.logger.Warn("error finding task users", "error", err)
+		} else {
+			for _, user := range users {
+				usernames[user.ID] = user.Username
+			}
+		}
+	}
+
+	result := make([]*taskAggregate.TaskResponse, len(tasks))
+	for i := range tasks {
+		result[i] = taskAggregate.NewTaskResponse(&tasks[i], usernames)
+	}
+
+	return result, nil
+}
+
+// AddTasks moves tasks into the sprint, from the backlog or from another sprint
+func (s *Service) AddTasks(id string, req *TasksRequest) error {
+	e, err := s.findByID(id)
+	if err != nil {
+		return err
+	}
+
+	if e.State == entity.StateCompleted {
+		return apperror.Conflict("sprint_is_completed")
+	}
+
+	return s.taskService.SetSprint(req.TaskIDs, &e.ID)
+}
+
+// RemoveTasks moves tasks of the sprint back to the backlog
+func (s *Service) RemoveTasks(id string, req *TasksRequest) error {
+	e, err := s.findByID(id)
+	if err != nil {
+		return err
+	}
+
+	if e.State == entity.StateCompleted {
+		return apperror.Conflict("sprint_is_completed")
+	}
+
+	tasks, err := s.taskRepository.FindByIDs(req.TaskIDs)
+	if err != nil {
+		s.logger.Error("error finding tasks", "error", err)
+		return apperror.Internal(err)
+	}
+
+	taskIDs := make([]uint, 0, len(tasks))
+	for _, t := range tasks {
+		if t.SprintID != nil && *t.SprintID == e.ID {
+			taskIDs = append(taskIDs, t.ID)
+		}
+	}
+
+	return s.taskService.SetSprint(taskIDs, nil)
+}
+
+// ********************* Start *********************
+// Start activates a planned sprint, only one sprint can be active at a time
+func (s *Service) Start(id string) (*aggregate.SprintResponse, error) {
+	e, err := s.findByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.State != entity.StatePlanned {
+		return nil, apperror.Conflict("sprint_is_not_planned")
+	}
+
+	active, err := s.repository.FindByState(entity.StateActive)
+	if err != nil {
+		s.logger.Error("error finding active sprints", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	if len(active) > 0 {
+		return nil, apperror.Conflict("another_sprint_is_active")
+	}
+
+	now := s.now()
+	e.State = entity.StateActive
+	e.StartedAt = &now
+
+	err = s.repository.Update(e)
+	if err != nil {
+		s.logger.Error("error starting sprint", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewSprintResponse(&e), nil
+}
+
+// ********************* Complete *********************
+type CompleteRequest struct {
+	// Sprint receiving the unfinished tasks, they go back to the backlog when omitted
+	NextSprintID *uint `json:"next_sprint_id" example:"13"`
+}
+
+// Complete closes an active sprint and carries its unfinished tasks over
+func (s *Service) Complete(id string, req *CompleteRequest) (*aggregate.CompleteResponse, error) {
+	e, err := s.findByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.State != entity.StateActive {
+		return nil, apperror.Conflict("sprint_is_not_active")
+	}
+
+	if req.NextSprintID != nil {
+		next, err := s.repository.FindByID(*req.NextSprintID)
+		if err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				s.logger.Error("error finding sprint", "error", err)
+				return nil, apperror.Internal(err)
+			}
+			return nil, apperror.Validation("next_sprint_not_found")
+		}
+
+		if next.ID == e.ID || next.State == entity.StateCompleted {
+			return nil, apperror.Validation("invalid_next_sprint")
+		}
+	}
+
+	tasks, err := s.taskRepository.FindBySprintID(e.ID)
+	if err != nil {
+		s.logger.Error("error finding sprint tasks", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	carriedOver := make([]uint, 0)
+	for _, t := range tasks {
+		if t.Status != taskEntity.StatusDone {
+			carriedOver = append(carriedOver, t.ID)
+		}
+	}
+
+	// The completion time is taken before the carry-over so it's recorded after it, but the sprint is only
+	// marked completed once the tasks moved, a failed move leaves it active and completing it again moves the rest
+	now := s.now()
+
+	err = s.taskService.SetSprint(carriedOver, req.NextSprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	e.State = entity.StateCompleted
+	e.CompletedAt = &now
+
+	err = s.repository.Update(e)
+	if err != nil {
+		s.logger.Error("error completing sprint", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return &aggregate.CompleteResponse{
+		Sprint:       aggregate.NewSprintResponse(&e),
+		CarriedOver:  carriedOver,
+		NextSprintID: req.NextSprintID,
+	}, nil
+}
+
+// ********************* Burndown *********************
+// Burndown returns the remaining work at the end of each sprint day, computed from the task status history
+// The scope is the tasks in the sprint plus the tasks carried over when it was completed
+func (s *Service) Burndown(id string) (*aggregate.BurndownResponse, error) {
+	e, err := s.findByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.taskRepository.FindBySprintID(e.ID)
+	if err != nil {
+		s.logger.Error("error finding sprint tasks", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	if e.CompletedAt != nil {
+		carriedOver, err := s.carriedOverTasks(e)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, carriedOver...)
+	}
+
+	var history []taskEntity.History
+	if len(tasks) > 0 {
+		taskIDs := make([]uint, len(tasks))
+		for i, t := range tasks {
+			taskIDs[i] = t.ID
+		}
+
+		history, err = s.taskRepository.FindHistory(taskIDs, taskEntity.HistoryFieldStatus)
+		if err != nil {
+			s.logger.Error("error finding task history", "error", err)
+			return nil, apperror.Internal(err)
+		}
+	}
+
+	end := e.EndDate
+	if e.CompletedAt != nil && e.CompletedAt.Before(end) {
+		end = *e.CompletedAt
+	}
+	if now := s.now(); now.Before(end) {
+		end = now
+	}
+
+	return burndown(e, end, tasks, history), nil
+}
+
+// Helper functions
+func (s *Service) findByID(id string) (entity.Sprint, error) {
+	uintID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		s.logger.Error("error parsing id", "error", err)
+		return entity.Sprint{}, apperror.BadRequest("invalid_id")
+	}
+
+	e, err := s.repository.FindByID(uint(uintID))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding sprint", "error", err)
+			return entity.Sprint{}, apperror.Internal(err)
+		}
+		s.logger.Error("sprint not found", "error", err)
+		return entity.Sprint{}, apperror.NotFound("sprint_not_found")
+	}
+
+	return e, nil
+}
+
+// carriedOverTasks returns the tasks that left the sprint when it was completed
+func (s *Service) carriedOverTasks(e entity.Sprint) ([]taskEntity.Task, error) {
+	entries, err := s.taskRepository.FindHistoryByOldValue(taskEntity.HistoryFieldSprint, strconv.FormatUint(uint64(e.ID), 10))
+	if err != nil {
+		s.logger.Error("error finding sprint history", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	taskIDs := make([]uint, 0)
+	seen := make(map[uint]bool)
+	for _, entry := range entries {
+		// Tasks removed before the completion are not part of the final scope
+		if entry.CreatedAt.Before(*e.CompletedAt) || seen[entry.TaskID] {
+			continue
+		}
+		seen[entry.TaskID] = true
+		taskIDs = append(taskIDs, entry.TaskID)
+	}
+
+	if len(taskIDs) == 0 {
+		return nil, nil
+	}
+
+	tasks, err := s.taskRepository.FindByIDs(taskIDs)
+	if err != nil {
+		s.logger.Error("error finding tasks", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return tasks, nil
+}
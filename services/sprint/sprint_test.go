@@ -0,0 +1,171 @@
+package sprint
+
+import (
+	"errors"
+	projectMocks "task_mng/domain/project/mocks"
+	"task_mng/domain/sprint/entity"
+	"task_mng/domain/sprint/mocks"
+	taskEntity "task_mng/domain/task/entity"
+	taskMocks "task_mng/domain/task/mocks"
+	userMocks "task_mng/domain/user/mocks"
+	notifierMocks "task_mng/pkg/notifier/mocks"
+	redisMocks "task_mng/pkg/redis/mocks"
+	storageMocks "task_mng/pkg/storage/mocks"
+	"task_mng/services/task"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func newTestService() (*Service, *mocks.MockSprintRepository, *taskMocks.MockTaskRepository) {
+	mockRepo := new(mocks.MockSprintRepository)
+	mockTaskRepo := new(taskMocks.MockTaskRepository)
+	mockUserRepo := new(userMocks.MockUserRepository)
+
+	// Mock CountByStatus for metrics updates of the task service
+	mockTaskRepo.On("CountByStatus").Return(map[taskEntity.Status]int64{}, nil)
+
+	taskService := task.New(mockTaskRepo, &redisMocks.MockRedisClient{}, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
+
+	service := New(mockRepo, mockTaskRepo, taskService, mockUserRepo)
+	service.now = func() time.Time { return at(20, 17) }
+
+	return service, mockRepo, mockTaskRepo
+}
+
+func uintPtr(v uint) *uint {
+	return &v
+}
+
+func TestCreate_InvalidDates(t *testing.T) {
+	service, mockRepo, _ := newTestService()
+
+	result, err := service.Create(&CreateRequest{Name: "Sprint 1", StartDate: at(20, 0), EndDate: at(6, 0)})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "end_date_must_be_after_start_date", err.Error())
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestStart_AnotherSprintActive(t *testing.T) {
+	service, mockRepo, _ := newTestService()
+
+	mockRepo.On("FindByID", uint(2)).Return(entity.Sprint{Model: gorm.Model{ID: 2}, State: entity.StatePlanned}, nil)
+	mockRepo.On("FindByState", entity.StateActive).Return([]entity.Sprint{{Model: gorm.Model{ID: 1}, State: entity.StateActive}}, nil)
+
+	result, err := service.Start("2")
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "another_sprint_is_active", err.Error())
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestComplete_CarriesOverUnfinishedTasks(t *testing.T) {
+	service, mockRepo, mockTaskRepo := newTestService()
+
+	mockRepo.On("FindByID", uint(1)).Return(entity.Sprint{Model: gorm.Model{ID: 1}, State: entity.StateActive}, nil)
+	mockRepo.On("FindByID", uint(2)).Return(entity.Sprint{Model: gorm.Model{ID: 2}, State: entity.StatePlanned}, nil)
+
+	inSprint := []taskEntity.Task{
+		{Model: gorm.Model{ID: 10}, Status: taskEntity.StatusDone, SprintID: uintPtr(1)},
+		{Model: gorm.Model{ID: 11}, Status: taskEntity.StatusInProgress, SprintID: uintPtr(1)},
+		{Model: gorm.Model{ID: 12}, Status: taskEntity.StatusTodo, SprintID: uintPtr(1)},
+	}
+	mockTaskRepo.On("FindBySprintID", uint(1)).Return(inSprint, nil)
+
+	mockRepo.On("Update", mock.MatchedBy(func(e entity.Sprint) bool {
+		return e.ID == 1 && e.State == entity.StateCompleted && e.CompletedAt != nil
+	})).Return(nil)
+
+	mockTaskRepo.On("FindByIDs", []uint{11, 12}).Return(inSprint[1:], nil)
+	mockTaskRepo.On("UpdateFields", uint(11), map[string]interface{}{"sprint_id": uintPtr(2)}).Return(nil)
+	mockTaskRepo.On("UpdateFields", uint(12), map[string]interface{}{"sprint_id": uintPtr(2)}).Return(nil)
+	mockTaskRepo.On("CreateHistory", []taskEntity.History{
+		{TaskID: 11, Field: taskEntity.HistoryFieldSprint, OldValue: "1", NewValue: "2"},
+		{TaskID: 12, Field: taskEntity.HistoryFieldSprint, OldValue: "1", NewValue: "2"},
+	}).Return(nil)
+
+	result, err := service.Complete("1", &CompleteRequest{NextSprintID: uintPtr(2)})
+
+	require.NoError(t, err)
+	assert.Equal(t, []uint{11, 12}, result.CarriedOver)
+	assert.Equal(t, entity.StateCompleted, result.Sprint.State)
+	mockRepo.AssertExpectations(t)
+	mockTaskRepo.AssertExpectations(t)
+}
+
+func TestComplete_MoveFails(t *testing.T) {
+	service, mockRepo, mockTaskRepo := newTestService()
+
+	mockRepo.On("FindByID", uint(1)).Return(entity.Sprint{Model: gorm.Model{ID: 1}, State: entity.StateActive}, nil)
+
+	inSprint := []taskEntity.Task{{Model: gorm.Model{ID: 11}, Status: taskEntity.StatusTodo, SprintID: uintPtr(1)}}
+	mockTaskRepo.On("FindBySprintID", uint(1)).Return(inSprint, nil)
+	mockTaskRepo.On("FindByIDs", []uint{11}).Return(inSprint, nil)
+	mockTaskRepo.On("UpdateFields", uint(11), map[string]interface{}{"sprint_id": (*uint)(nil)}).Return(errors.New("connection reset"))
+
+	result, err := service.Complete("1", &CompleteRequest{})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	// The sprint stays active so completing it can be retried
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestComplete_NotActive(t *testing.T) {
+	service, mockRepo, mockTaskRepo := newTestService()
+
+	mockRepo.On("FindByID", uint(1)).Return(entity.Sprint{Model: gorm.Model{ID: 1}, State: entity.StatePlanned}, nil)
+
+	result, err := service.Complete("1", &CompleteRequest{})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "sprint_is_not_active", err.Error())
+	mockTaskRepo.AssertNotCalled(t, "FindBySprintID", mock.Anything)
+}
+
+func TestBurndown_IncludesCarriedOverTasks(t *testing.T) {
+	service, mockRepo, mockTaskRepo := newTestService()
+
+	completedAt := at(9, 17)
+	mockRepo.On("FindByID", uint(1)).Return(entity.Sprint{
+		Model:       gorm.Model{ID: 1},
+		StartDate:   at(6, 9),
+		EndDate:     at(9, 18),
+		State:       entity.StateCompleted,
+		CompletedAt: &completedAt,
+	}, nil)
+
+	mockTaskRepo.On("FindBySprintID", uint(1)).Return([]taskEntity.Task{
+		{Model: gorm.Model{ID: 10}, Status: taskEntity.StatusDone},
+	}, nil)
+	mockTaskRepo.On("FindHistoryByOldValue", taskEntity.HistoryFieldSprint, "1").Return([]taskEntity.History{
+		// Removed during the sprint, not part of the scope
+		{TaskID: 13, Field: taskEntity.HistoryFieldSprint, OldValue: "1", CreatedAt: at(7, 10)},
+		// Carried over on completion
+		{TaskID: 11, Field: taskEntity.HistoryFieldSprint, OldValue: "1", NewValue: "2", CreatedAt: at(9, 17)},
+	}, nil)
+	mockTaskRepo.On("FindByIDs", []uint{11}).Return([]taskEntity.Task{
+		{Model: gorm.Model{ID: 11}, Status: taskEntity.StatusInProgress},
+	}, nil)
+	mockTaskRepo.On("FindHistory", []uint{10, 11}, taskEntity.HistoryFieldStatus).Return([]taskEntity.History{
+		{TaskID: 10, OldValue: "ToDo", NewValue: "Done", CreatedAt: at(8, 12)},
+	}, nil)
+
+	result, err := service.Burndown("1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.TotalTasks)
+	require.Len(t, result.Points, 4)
+	assert.Equal(t, 2, result.Points[1].RemainingTasks)
+	assert.Equal(t, 1, result.Points[2].RemainingTasks)
+	assert.Equal(t, 1, result.Points[3].RemainingTasks)
+	mockTaskRepo.AssertExpectations(t)
+}
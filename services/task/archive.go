@@ -0,0 +1,98 @@
+package task
+
+import (
+	"context"
+	"task_mng/pkg/apperror"
+	"time"
+)
+
+// ArchiveConfig controls the automatic archiving of done tasks
+type ArchiveConfig struct {
+	// After is how long a task has to be done before it gets archived
+	After time.Duration
+}
+
+// ********************* Archive *********************
+
+// Archive hides a task from listings, the board and the status counts without deleting it
+func (s *Service) Archive(id string, userID uint) error {
+	t, err := s.findTask(id)
+	if err != nil {
+		return err
+	}
+
+	if t.ArchivedAt != nil {
+		return apperror.Conflict("task_already_archived")
+	}
+
+	err = s.repository.UpdateFields(t.ID, map[string]interface{}{
+		"archived_at": time.Now().UTC(),
+		"updated_by":  userRef(userID),
+	})
+	if err != nil {
+		s.logger.Error("error archiving task", "error", err)
+		return apperror.Internal(err)
+	}
+
+	// Invalidate cache after archiving a task
+	s.invalidateTasksCache()
+
+	// Update task count metrics
+	s.updateTaskMetrics()
+
+	return nil
+}
+
+// Unarchive brings an archived task back, its board column has to have room for it
+func (s *Service) Unarchive(id string, userID uint) error {
+	t, err := s.findTask(id)
+	if err != nil {
+		return err
+	}
+
+	if t.ArchivedAt == nil {
+		return apperror.Conflict("task_not_archived")
+	}
+
+	if err := s.checkWipLimit(t.Status); err != nil {
+		return err
+	}
+
+	err = s.repository.UpdateFields(t.ID, map[string]interface{}{
+		"archived_at": nil,
+		"updated_by":  userRef(userID),
+	})
+	if err != nil {
+		s.logger.Error("error unarchiving task", "error", err)
+		return apperror.Internal(err)
+	}
+
+	// Invalidate cache after unarchiving a task
+	s.invalidateTasksCache()
+
+	// Update task count metrics
+	s.updateTaskMetrics()
+
+	return nil
+}
+
+// ArchiveDoneTasks archives the tasks that have been done for longer than the configured period
+func (s *Service) ArchiveDoneTasks(ctx context.Context, cfg ArchiveConfig) error {
+	archived, err := s.repository.ArchiveDone(time.Now().UTC().Add(-cfg.After))
+	if err != nil {
+		s.logger.Error("error archiving done tasks", "error", err)
+		return err
+	}
+
+	if archived > 0 {
+		s.logger.Info("Archived done tasks", "count", archived, "after", cfg.After)
+
+		// Invalidate cache after archiving tasks
+		s.invalidateTasksCache()
+
+		// Update task count metrics
+		s.updateTaskMetrics()
+	}
+
+	return nil
+}
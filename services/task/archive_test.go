@@ -0,0 +1,68 @@
+package task
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"task_mng/domain/task/entity"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestArchive_Success(t *testing.T) {
+	service, mockRepo := newBoardTestService()
+
+	mockRepo.On("FindByID", uint(1)).Return(entity.Task{Model: gorm.Model{ID: 1}, Status: entity.StatusDone}, nil)
+	mockRepo.On("UpdateFields", uint(1), mock.MatchedBy(func(fields map[string]interface{}) bool {
+		_, ok := fields["archived_at"].(time.Time)
+		updatedBy, _ := fields["updated_by"].(*uint)
+		return ok && updatedBy != nil && *updatedBy == 5
+	})).Return(nil)
+
+	err := service.Archive("1", 5)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestArchive_AlreadyArchived(t *testing.T) {
+	service, mockRepo := newBoardTestService()
+
+	archivedAt := time.Now()
+	mockRepo.On("FindByID", uint(1)).Return(entity.Task{Model: gorm.Model{ID: 1}, ArchivedAt: &archivedAt}, nil)
+
+	err := service.Archive("1", 5)
+
+	assert.Error(t, err)
+	assert.Equal(t, "task_already_archived", err.Error())
+	mockRepo.AssertNotCalled(t, "UpdateFields", mock.Anything, mock.Anything)
+}
+
+func TestUnarchive_NotArchived(t *testing.T) {
+	service, mockRepo := newBoardTestService()
+
+	mockRepo.On("FindByID", uint(1)).Return(entity.Task{Model: gorm.Model{ID: 1}, Status: entity.StatusDone}, nil)
+
+	err := service.Unarchive("1", 5)
+
+	assert.Error(t, err)
+	assert.Equal(t, "task_not_archived", err.Error())
+	mockRepo.AssertNotCalled(t, "UpdateFields", mock.Anything, mock.Anything)
+}
+
+func TestArchiveDoneTasks_UsesPeriod(t *testing.T) {
+	service, mockRepo := newBoardTestService()
+
+	after := 14 * 24 * time.Hour
+	mockRepo.On("ArchiveDone", mock.MatchedBy(func(before time.Time) bool {
+		return time.Since(before) >= after && time.Since(before) < after+time.Minute
+	})).Return(int64(2), nil)
+
+	err := service.ArchiveDoneTasks(context.Background(), ArchiveConfig{After: after})
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,266 @@
+package task
+
+import (
+	"errors"
+	"strconv"
+	"task_mng/domain/task"
+	"task_mng/domain/task/aggregate"
+	"task_mng/domain/task/entity"
+	"task_mng/pkg/apperror"
+
+	"gorm.io/gorm"
+)
+
+// ********************* Board *********************
+type BoardRequest struct {
+	Assignee *string `form:"assignee"`
+	Sprint   *uint   `form:"sprint"`
+}
+
+// Board returns the tasks grouped by status column in rank order
+func (s *Service) Board(req *BoardRequest) (*aggregate.BoardResponse, error) {
+	filter := &task.Filter{Sprint: req.Sprint}
+	if req.Assignee != nil {
+		user, err := s.userRepository.FindByUsername(*req.Assignee)
+		if err != nil {
+			s.logger.Error("error finding user", "error", err)
+			return nil, apperror.Validation("can't find assignee user")
+		}
+		filter.Assignee = &user.ID
+	}
+
+	tasks, err := s.repository.FindBoard(filter)
+	if err != nil {
+		s.logger.Error("error finding board tasks", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	columns, err := s.repository.FindColumns()
+	if err != nil {
+		s.logger.Error("error finding board columns", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewBoardResponse(tasks, columns, s.usernames(tasks)), nil
+}
+
+// ********************* Columns *********************
+func (s *Service) Columns() ([]aggregate.ColumnResponse, error) {
+	columns, err := s.repository.FindColumns()
+	if err != nil {
+		s.logger.Error("error finding board columns", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewColumnListResponse(columns), nil
+}
+
+type UpdateColumnRequest struct {
+	WipLimit int `json:"wip_limit" example:"5"`
+}
+
+// UpdateColumn sets the WIP limit of a status column, 0 removes the limit
+func (s *Service) UpdateColumn(status string, req *UpdateColumnRequest) error {
+	if !isBoardStatus(entity.Status(status)) {
+		return apperror.Validation("invalid_status")
+	}
+
+	if req.WipLimit < 0 {
+		return apperror.Validation("invalid_wip_limit")
+	}
+
+	err := s.repository.SaveColumn(entity.Column{Status: entity.Status(status), WipLimit: req.WipLimit})
+	if err != nil {
+		s.logger.Error("error saving board column", "error", err)
+		return apperror.Internal(err)
+	}
+
+	return nil
+}
+
+// ********************* Move *********************
+type MoveRequest struct {
+	Status entity.Status `json:"status" valid:"required~status_is_required,in(ToDo|InProgress|Done)~invalid_status" example:"InProgress"`
+	// Zero based position inside the target column, the task goes to the end when omitted
+	Position *int `json:"position" example:"0"`
+}
+
+// Move places a task at a position of a status column
+// Only the moved task gets a new rank unless its neighbours have to be spread out too
+func (s *Service) Move(id string, req *MoveRequest, userID uint) error {
+	uintID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		s.logger.Error("error parsing id", "error", err)
+		return apperror.BadRequest("invalid_id")
+	}
+
+	t, err := s.repository.FindByID(uint(uintID))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding task", "error", err)
+			return apperror.Internal(err)
+		}
+		s.logger.Error("task not found", "error", err)
+		return apperror.NotFound("task_not_found")
+	}
+
+	statusChanged := t.Status != req.Status
+	if statusChanged {
+		if err := s.checkWipLimit(req.Status); err != nil {
+			return err
+		}
+	}
+
+	column, err := s.repository.FindByStatus(req.Status)
+	if err != nil {
+		s.logger.Error("error finding column tasks", "error", err)
+		return apperror.Internal(err)
+	}
+
+	others := make([]entity.Task, 0, len(column))
+	for _, c := range column {
+		if c.ID != t.ID {
+			others = append(others, c)
+		}
+	}
+
+	position := len(others)
+	if req.Position != nil {
+		position = min(max(*req.Position, 0), len(others))
+	}
+
+	rank, rebalanced := rankAt(others, position)
+
+	fields := map[string]interface{}{
+		"status":     req.Status,
+		"rank":       rank,
+		"updated_by": userRef(userID),
+	}
+	if statusChanged {
+		fields["completed_at"] = completedAt(req.Status)
+	}
+
+	if len(rebalanced) > 0 {
+		// The neighbours share a rank, e.g. tasks created before ranking existed, so the column is spread again
+		s.logger.Info("rebalancing board column ranks", "tasks", len(others)+1)
+		err = s.repository.Rebalance(t.ID, fields, rebalanced)
+	} else {
+		err = s.repository.UpdateFields(t.ID, fields)
+	}
+	if err != nil {
+		s.logger.Error("error moving task", "error", err)
+		return apperror.Internal(err)
+	}
+
+	if statusChanged {
+		s.recordHistory(entity.History{
+			TaskID:   t.ID,
+			Field:    entity.HistoryFieldStatus,
+			OldValue: string(t.Status),
+			NewValue: string(req.Status),
+			UserID:   userRef(userID),
+		})
+
+		oldStatus := t.Status
+		t.Status = req.Status
+		s.notifyStatusChange(t, oldStatus, userID)
+	}
+
+	// Invalidate cache after moving a task
+	s.invalidateTasksCache()
+
+	if statusChanged {
+		// Update task count metrics
+		s.updateTaskMetrics()
+	}
+
+	return nil
+}
+
+// Helper functions
+
+// rankAt returns the rank for a task inserted at position between the ranked tasks of a column
+// When there is no rank between its neighbours, the new ranks of the other tasks are returned too
+func rankAt(others []entity.Task, position int) (string, map[uint]string) {
+	prev, next := "", ""
+	if position > 0 {
+		prev = others[position-1].Rank
+	}
+	if position < len(others) {
+		next = others[position].Rank
+	}
+
+	// An unranked next task would be treated as the end of the column
+	if position == len(others) || next != "" {
+		rank, err := entity.RankBetween(prev, next)
+		if err == nil {
+			return rank, nil
+		}
+	}
+
+	ranks := entity.RankSequence(len(others) + 1)
+	updates := make(map[uint]string, len(others))
+	for i, other := range others {
+		j := i
+		if i >= position {
+			j++
+		}
+		updates[other.ID] = ranks[j]
+	}
+
+	return ranks[position], updates
+}
+
+// checkWipLimit fails when the status column already holds its WIP limit of tasks
+func (s *Service) checkWipLimit(status entity.Status) error {
+	column, err := s.repository.FindColumn(status)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		s.logger.Error("error finding board column", "error", err)
+		return apperror.Internal(err)
+	}
+
+	if column.WipLimit <= 0 {
+		return nil
+	}
+
+	counts, err := s.repository.CountByStatus()
+	if err != nil {
+		s.logger.Error("error counting tasks", "error", err)
+		return apperror.Internal(err)
+	}
+
+	if counts[status] >= int64(column.WipLimit) {
+		return apperror.Conflict("wip_limit_reached")
+	}
+
+	return nil
+}
+
+// endRank returns a rank after every task of the status column
+func (s *Service) endRank(status entity.Status) (string, error) {
+	last, err := s.repository.LastRank(status)
+	if err != nil {
+		s.logger.Error("error finding last rank", "error", err)
+		return "", apperror.Internal(err)
+	}
+
+	rank, err := entity.RankBetween(last, "")
+	if err != nil {
+		s.logger.Error("error computing rank", "error", err)
+		return "", apperror.Internal(err)
+	}
+
+	return rank, nil
+}
+
+func isBoardStatus(status entity.Status) bool {
+	for _, s := range aggregate.BoardStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
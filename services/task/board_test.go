@@ -0,0 +1,132 @@
+package task
+
+import (
+	"context"
+	"testing"
+
+	projectMocks "task_mng/domain/project/mocks"
+	"task_mng/domain/task/entity"
+	"task_mng/domain/task/mocks"
+	userMocks "task_mng/domain/user/mocks"
+	notifierMocks "task_mng/pkg/notifier/mocks"
+	redisMocks "task_mng/pkg/redis/mocks"
+	storageMocks "task_mng/pkg/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func newBoardTestService() (*Service, *mocks.MockTaskRepository) {
+	mockRepo := new(mocks.MockTaskRepository)
+	redisMock := &redisMocks.MockRedisClient{
+		IncrFunc: func(ctx context.Context, key string) error {
+			return nil
+		},
+	}
+	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil).Maybe()
+
+	return New(mockRepo, redisMock, new(userMocks.MockUserRepository), &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{}), mockRepo
+}
+
+func TestMove_BetweenTasks(t *testing.T) {
+	service, mockRepo := newBoardTestService()
+
+	mockRepo.On("FindByID", uint(3)).Return(entity.Task{Model: gorm.Model{ID: 3}, Status: entity.StatusTodo, Rank: "k"}, nil)
+	mockRepo.On("FindByStatus", entity.StatusTodo).Return([]entity.Task{
+		{Model: gorm.Model{ID: 1}, Status: entity.StatusTodo, Rank: "a"},
+		{Model: gorm.Model{ID: 2}, Status: entity.StatusTodo, Rank: "c"},
+		{Model: gorm.Model{ID: 3}, Status: entity.StatusTodo, Rank: "k"},
+	}, nil)
+	mockRepo.On("UpdateFields", uint(3), map[string]interface{}{
+		"status":     entity.StatusTodo,
+		"rank":       "b",
+		"updated_by": userRef(1),
+	}).Return(nil)
+
+	position := 1
+	err := service.Move("3", &MoveRequest{Status: entity.StatusTodo, Position: &position}, 1)
+
+	assert.NoError(t, err)
+	mockRepo.AssertNotCalled(t, "Rebalance", mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "CreateHistory", mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestMove_OtherColumnRecordsHistory(t *testing.T) {
+	service, mockRepo := newBoardTestService()
+
+	mockRepo.On("FindByID", uint(3)).Return(entity.Task{Model: gorm.Model{ID: 3}, Status: entity.StatusTodo, Rank: "k"}, nil)
+	mockRepo.On("FindColumn", entity.StatusInProgress).Return(entity.Column{}, gorm.ErrRecordNotFound)
+	mockRepo.On("FindByStatus", entity.StatusInProgress).Return([]entity.Task{
+		{Model: gorm.Model{ID: 1}, Status: entity.StatusInProgress, Rank: "i"},
+	}, nil)
+	mockRepo.On("UpdateFields", uint(3), mock.MatchedBy(func(fields map[string]interface{}) bool {
+		rank, _ := fields["rank"].(string)
+		return fields["status"] == entity.StatusInProgress && rank > "i"
+	})).Return(nil)
+	mockRepo.On("CreateHistory", []entity.History{{
+		TaskID:   3,
+		Field:    entity.HistoryFieldStatus,
+		OldValue: string(entity.StatusTodo),
+		NewValue: string(entity.StatusInProgress),
+		UserID:   userRef(1),
+	}}).Return(nil)
+	mockRepo.On("FindWatchers", uint(3)).Return([]entity.Watcher{}, nil)
+
+	err := service.Move("3", &MoveRequest{Status: entity.StatusInProgress}, 1)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestMove_RebalancesEqualRanks(t *testing.T) {
+	service, mockRepo := newBoardTestService()
+
+	mockRepo.On("FindByID", uint(3)).Return(entity.Task{Model: gorm.Model{ID: 3}, Status: entity.StatusTodo}, nil)
+	mockRepo.On("FindByStatus", entity.StatusTodo).Return([]entity.Task{
+		{Model: gorm.Model{ID: 1}, Status: entity.StatusTodo},
+		{Model: gorm.Model{ID: 2}, Status: entity.StatusTodo},
+		{Model: gorm.Model{ID: 3}, Status: entity.StatusTodo},
+	}, nil)
+
+	ranks := entity.RankSequence(3)
+	mockRepo.On("Rebalance", uint(3), map[string]interface{}{
+		"status":     entity.StatusTodo,
+		"rank":       ranks[1],
+		"updated_by": userRef(1),
+	}, map[uint]string{1: ranks[0], 2: ranks[2]}).Return(nil)
+
+	position := 1
+	err := service.Move("3", &MoveRequest{Status: entity.StatusTodo, Position: &position}, 1)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestMove_WipLimitReached(t *testing.T) {
+	mockRepo := new(mocks.MockTaskRepository)
+	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{entity.StatusInProgress: 2}, nil)
+	service := New(mockRepo, &redisMocks.MockRedisClient{}, new(userMocks.MockUserRepository), &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
+
+	mockRepo.On("FindByID", uint(3)).Return(entity.Task{Model: gorm.Model{ID: 3}, Status: entity.StatusTodo}, nil)
+	mockRepo.On("FindColumn", entity.StatusInProgress).Return(entity.Column{Status: entity.StatusInProgress, WipLimit: 2}, nil)
+
+	err := service.Move("3", &MoveRequest{Status: entity.StatusInProgress}, 1)
+
+	assert.Error(t, err)
+	assert.Equal(t, "wip_limit_reached", err.Error())
+	mockRepo.AssertNotCalled(t, "UpdateFields", mock.Anything, mock.Anything)
+}
+
+func TestUpdateColumn_InvalidLimit(t *testing.T) {
+	service, mockRepo := newBoardTestService()
+
+	err := service.UpdateColumn(string(entity.StatusDone), &UpdateColumnRequest{WipLimit: -1})
+	assert.Equal(t, "invalid_wip_limit", err.Error())
+
+	err = service.UpdateColumn("Blocked", &UpdateColumnRequest{WipLimit: 1})
+	assert.Equal(t, "invalid_status", err.Error())
+
+	mockRepo.AssertNotCalled(t, "SaveColumn", mock.Anything)
+}
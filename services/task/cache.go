@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"task_mng/domain/task/aggregate"
 	"time"
 
@@ -56,8 +59,28 @@ func (s *Service) generateCacheKey(ctx context.Context, filter *FilterRequest, p
 		priority = string(*filter.Priority)
 	}
 
-	return fmt.Sprintf("tasks:list:v%s:assignee:%s:status:%s:priority:%s:page:%d:limit:%d",
-		version, assignee, status, priority, page, limit), nil
+	reporter := "nil"
+	if filter.Reporter != nil {
+		reporter = *filter.Reporter
+	}
+
+	project := "nil"
+	if filter.Project != nil {
+		project = strconv.FormatUint(uint64(*filter.Project), 10)
+	}
+
+	customFields := "nil"
+	if len(filter.CustomFields) > 0 {
+		pairs := make([]string, 0, len(filter.CustomFields))
+		for key, value := range filter.CustomFields {
+			pairs = append(pairs, key+"="+value)
+		}
+		sort.Strings(pairs)
+		customFields = strings.Join(pairs, ",")
+	}
+
+	return fmt.Sprintf("tasks:list:v%s:assignee:%s:status:%s:priority:%s:reporter:%s:project:%s:cf:%s:archived:%t:page:%d:limit:%d",
+		version, assignee, status, priority, reporter, project, customFields, filter.IncludeArchived, page, limit), nil
 }
 
 // invalidateTasksCache invalidates all tasks cache entries by incrementing the cache version
@@ -3,10 +3,13 @@ package task
 import (
 	"context"
 	"fmt"
+	projectMocks "task_mng/domain/project/mocks"
 	"task_mng/domain/task/entity"
 	"task_mng/domain/task/mocks"
 	userMocks "task_mng/domain/user/mocks"
+	notifierMocks "task_mng/pkg/notifier/mocks"
 	redisMocks "task_mng/pkg/redis/mocks"
+	storageMocks "task_mng/pkg/storage/mocks"
 	"testing"
 	"time"
 
@@ -37,7 +40,7 @@ func TestGetCacheVersion_FirstTime(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	version, err := service.getCacheVersion(context.Background())
 
@@ -62,7 +65,7 @@ func TestGetCacheVersion_ExistingVersion(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	version, err := service.getCacheVersion(context.Background())
 
@@ -83,7 +86,7 @@ func TestGetCacheVersion_RedisError(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	version, err := service.getCacheVersion(context.Background())
 
@@ -108,13 +111,13 @@ func TestGenerateCacheKey_AllFiltersNil(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	filter := &FilterRequest{}
 	key, err := service.generateCacheKey(context.Background(), filter, 1, 10)
 
 	assert.NoError(t, err)
-	assert.Equal(t, "tasks:list:v1:assignee:nil:status:nil:priority:nil:page:1:limit:10", key)
+	assert.Equal(t, "tasks:list:v1:assignee:nil:status:nil:priority:nil:reporter:nil:project:nil:cf:nil:archived:false:page:1:limit:10", key)
 	mockRepo.AssertExpectations(t)
 	mockUserRepo.AssertExpectations(t)
 }
@@ -133,7 +136,7 @@ func TestGenerateCacheKey_WithFilters(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	assignee := "john.doe"
 	status := entity.StatusInProgress
@@ -147,7 +150,7 @@ func TestGenerateCacheKey_WithFilters(t *testing.T) {
 	key, err := service.generateCacheKey(context.Background(), filter, 2, 20)
 
 	assert.NoError(t, err)
-	assert.Equal(t, "tasks:list:v2:assignee:john.doe:status:InProgress:priority:high:page:2:limit:20", key)
+	assert.Equal(t, "tasks:list:v2:assignee:john.doe:status:InProgress:priority:high:reporter:nil:project:nil:cf:nil:archived:false:page:2:limit:20", key)
 	mockRepo.AssertExpectations(t)
 	mockUserRepo.AssertExpectations(t)
 }
@@ -166,7 +169,7 @@ func TestGenerateCacheKey_PartialFilters(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	status := entity.StatusDone
 
@@ -176,7 +179,7 @@ func TestGenerateCacheKey_PartialFilters(t *testing.T) {
 	key, err := service.generateCacheKey(context.Background(), filter, 1, 15)
 
 	assert.NoError(t, err)
-	assert.Equal(t, "tasks:list:v3:assignee:nil:status:Done:priority:nil:page:1:limit:15", key)
+	assert.Equal(t, "tasks:list:v3:assignee:nil:status:Done:priority:nil:reporter:nil:project:nil:cf:nil:archived:false:page:1:limit:15", key)
 	mockRepo.AssertExpectations(t)
 	mockUserRepo.AssertExpectations(t)
 }
@@ -192,7 +195,7 @@ func TestGenerateCacheKey_RedisError(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	filter := &FilterRequest{}
 	key, err := service.generateCacheKey(context.Background(), filter, 1, 10)
@@ -220,7 +223,7 @@ func TestInvalidateTasksCache_Success(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	service.invalidateTasksCache()
 
@@ -240,7 +243,7 @@ func TestInvalidateTasksCache_RedisError(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	service.invalidateTasksCache()
 
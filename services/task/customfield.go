@@ -0,0 +1,124 @@
+package task
+
+import (
+	"errors"
+	"strconv"
+	projectEntity "task_mng/domain/project/entity"
+	"task_mng/pkg/apperror"
+
+	"gorm.io/gorm"
+)
+
+// ********************* Custom Fields *********************
+
+// customFields applies changes to the custom field values of a task in a project and returns the values to store
+// A nil value removes the field, required fields must have a value when creating and can't be removed afterwards
+func (s *Service) customFields(projectID uint, current, changes map[string]interface{}, creating bool) (map[string]interface{}, error) {
+	definitions, err := s.fieldDefinitions(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]interface{}, len(current)+len(changes))
+	for key, value := range current {
+		values[key] = value
+	}
+
+	for key, value := range changes {
+		field, ok := definitions[key]
+		if !ok {
+			s.logger.Info("unknown custom field", "project_id", projectID, "key", key)
+			return nil, apperror.Validation("unknown_custom_field")
+		}
+
+		if value == nil {
+			if field.Required {
+				return nil, apperror.Validation("custom_field_required")
+			}
+			delete(values, key)
+			continue
+		}
+
+		normalized, err := field.Normalize(value)
+		if err != nil {
+			s.logger.Info("invalid custom field value", "project_id", projectID, "error", err)
+			return nil, apperror.Validation("invalid_custom_field_value")
+		}
+
+		if field.Type == projectEntity.FieldTypeUser {
+			user, err := s.userRepository.FindByUsername(normalized.(string))
+			if err != nil {
+				s.logger.Info("custom field user not found", "key", key, "error", err)
+				return nil, apperror.Validation("invalid_custom_field_value")
+			}
+			normalized = user.ID
+		}
+
+		values[key] = normalized
+	}
+
+	if creating {
+		for _, field := range definitions {
+			if _, ok := values[field.Key]; field.Required && !ok {
+				return nil, apperror.Validation("custom_field_required")
+			}
+		}
+	}
+
+	return values, nil
+}
+
+// customFieldFilter converts custom field filters given as query values to the stored text form,
+// user fields are filtered by username and stored by id
+func (s *Service) customFieldFilter(projectID *uint, filters map[string]string) (map[string]string, error) {
+	if len(filters) == 0 || projectID == nil {
+		return filters, nil
+	}
+
+	definitions, err := s.fieldDefinitions(*projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(filters))
+	for key, value := range filters {
+		field, ok := definitions[key]
+		if !ok {
+			return nil, apperror.Validation("unknown_custom_field")
+		}
+
+		if field.Type == projectEntity.FieldTypeUser {
+			user, err := s.userRepository.FindByUsername(value)
+			if err != nil {
+				return nil, apperror.Validation("invalid_custom_field_value")
+			}
+			value = strconv.FormatUint(uint64(user.ID), 10)
+		}
+
+		result[key] = value
+	}
+	return result, nil
+}
+
+// fieldDefinitions returns the custom field definitions of a project keyed by field key
+func (s *Service) fieldDefinitions(projectID uint) (map[string]projectEntity.Field, error) {
+	if _, err := s.projectRepository.FindByID(projectID); err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding project", "error", err)
+			return nil, apperror.Internal(err)
+		}
+		return nil, apperror.NotFound("project_not_found")
+	}
+
+	fields, err := s.projectRepository.FindFields(projectID)
+	if err != nil {
+		s.logger.Error("error finding project fields", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	definitions := make(map[string]projectEntity.Field, len(fields))
+	for _, field := range fields {
+		definitions[field.Key] = field
+	}
+	return definitions, nil
+}
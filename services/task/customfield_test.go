@@ -0,0 +1,107 @@
+package task
+
+import (
+	"testing"
+
+	projectEntity "task_mng/domain/project/entity"
+	projectMocks "task_mng/domain/project/mocks"
+	"task_mng/domain/task/entity"
+	"task_mng/domain/task/mocks"
+	userEntity "task_mng/domain/user/entity"
+	userMocks "task_mng/domain/user/mocks"
+	notifierMocks "task_mng/pkg/notifier/mocks"
+	redisMocks "task_mng/pkg/redis/mocks"
+	storageMocks "task_mng/pkg/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func newCustomFieldTestService() (*Service, *mocks.MockTaskRepository, *userMocks.MockUserRepository) {
+	mockRepo := new(mocks.MockTaskRepository)
+	mockUserRepo := new(userMocks.MockUserRepository)
+	mockProjectRepo := new(projectMocks.MockProjectRepository)
+
+	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil).Maybe()
+
+	mockProjectRepo.On("FindByID", uint(1)).Return(projectEntity.Project{Model: gorm.Model{ID: 1}}, nil)
+	mockProjectRepo.On("FindByID", uint(2)).Return(projectEntity.Project{}, gorm.ErrRecordNotFound)
+	mockProjectRepo.On("FindFields", uint(1)).Return([]projectEntity.Field{
+		{ProjectID: 1, Key: "customer", Type: projectEntity.FieldTypeText, Required: true},
+		{ProjectID: 1, Key: "points", Type: projectEntity.FieldTypeNumber},
+		{ProjectID: 1, Key: "env", Type: projectEntity.FieldTypeSelect, Options: []string{"staging", "prod"}},
+		{ProjectID: 1, Key: "reviewer", Type: projectEntity.FieldTypeUser},
+	}, nil)
+
+	service := New(mockRepo, &redisMocks.MockRedisClient{}, mockUserRepo, &notifierMocks.MockNotifier{}, mockProjectRepo, &storageMocks.MockStorage{})
+	return service, mockRepo, mockUserRepo
+}
+
+func TestCreateTask_CustomFields(t *testing.T) {
+	service, mockRepo, mockUserRepo := newCustomFieldTestService()
+
+	mockUserRepo.On("FindByUsername", "admin").Return(userEntity.User{Model: gorm.Model{ID: 1}, Username: "admin"}, nil)
+	mockUserRepo.On("FindByUsername", "reviewer").Return(userEntity.User{Model: gorm.Model{ID: 4}, Username: "reviewer"}, nil)
+	mockRepo.On("Create", mock.MatchedBy(func(t *entity.Task) bool {
+		return *t.ProjectID == 1 &&
+			t.CustomFields["customer"] == "ACME" &&
+			t.CustomFields["env"] == "prod" &&
+			t.CustomFields["reviewer"] == uint(4)
+	})).Return(nil)
+	mockRepo.On("AddWatchers", uint(0), []uint{1}).Return(nil)
+
+	projectID := uint(1)
+	err := service.Create(&CreateRequest{
+		Summary:   "Deploy",
+		Assignee:  "admin",
+		ProjectID: &projectID,
+		CustomFields: map[string]interface{}{
+			"customer": "ACME",
+			"env":      "prod",
+			"reviewer": "reviewer",
+		},
+	}, 1)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCustomFields_Errors(t *testing.T) {
+	service, _, _ := newCustomFieldTestService()
+
+	tests := []struct {
+		name      string
+		projectID uint
+		current   map[string]interface{}
+		changes   map[string]interface{}
+		creating  bool
+		want      string
+	}{
+		{"unknown project", 2, nil, map[string]interface{}{"customer": "ACME"}, true, "project_not_found"},
+		{"unknown field", 1, nil, map[string]interface{}{"customer": "ACME", "color": "red"}, true, "unknown_custom_field"},
+		{"invalid value", 1, nil, map[string]interface{}{"customer": "ACME", "env": "qa"}, true, "invalid_custom_field_value"},
+		{"required missing", 1, nil, map[string]interface{}{"points": float64(3)}, true, "custom_field_required"},
+		{"required removed", 1, map[string]interface{}{"customer": "ACME"}, map[string]interface{}{"customer": nil}, false, "custom_field_required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.customFields(tt.projectID, tt.current, tt.changes, tt.creating)
+			assert.Error(t, err)
+			assert.Equal(t, tt.want, err.Error())
+		})
+	}
+}
+
+func TestCustomFields_UpdateMergesValues(t *testing.T) {
+	service, _, _ := newCustomFieldTestService()
+
+	values, err := service.customFields(1,
+		map[string]interface{}{"customer": "ACME", "points": float64(3), "env": "staging"},
+		map[string]interface{}{"points": float64(5), "env": nil},
+		false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"customer": "ACME", "points": float64(5)}, values)
+}
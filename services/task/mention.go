@@ -0,0 +1,118 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"task_mng/domain/task/aggregate"
+	"task_mng/domain/task/entity"
+	"task_mng/pkg/apperror"
+	"task_mng/pkg/notifier"
+)
+
+// ********************* Mentions *********************
+
+// Mentions returns the tasks the user was mentioned in, newest first
+func (s *Service) Mentions(userID uint, page, limit int) (*aggregate.MentionListResponse, error) {
+	mentions, count, err := s.repository.FindMentions(userID, page, limit)
+	if err != nil {
+		s.logger.Error("error finding mentions", "user_id", userID, "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	taskIDs := make([]uint, 0)
+	userIDs := make([]uint, 0)
+	seenTasks := make(map[uint]bool)
+	seenUsers := make(map[uint]bool)
+	for _, m := range mentions {
+		if !seenTasks[m.TaskID] {
+			taskIDs = append(taskIDs, m.TaskID)
+			seenTasks[m.TaskID] = true
+		}
+		if m.MentionedBy != nil && !seenUsers[*m.MentionedBy] {
+			userIDs = append(userIDs, *m.MentionedBy)
+			seenUsers[*m.MentionedBy] = true
+		}
+	}
+
+	summaries := make(map[uint]string)
+	if len(taskIDs) > 0 {
+		tasks, err := s.repository.FindByIDs(taskIDs)
+		if err != nil {
+			s.logger.Warn("error finding mentioned tasks", "error", err)
+		} else {
+			for _, t := range tasks {
+				summaries[t.ID] = t.Summary
+			}
+		}
+	}
+
+	usernames := make(map[uint]string)
+	if len(userIDs) > 0 {
+		users, err := s.userRepository.FindByIDs(userIDs)
+		if err != nil {
+			s.logger.Warn("error finding mentioning users", "error", err)
+		} else {
+			for _, user := range users {
+				usernames[user.ID] = user.Username
+			}
+		}
+	}
+
+	return aggregate.NewMentionListResponse(mentions, summaries, usernames, page, limit, count), nil
+}
+
+// Helper functions
+
+// recordMentions stores and notifies the users mentioned in newText but not already in oldText,
+// so saving a description again doesn't notify the same people twice
+// Unknown usernames and the author mentioning themselves are skipped, failures are logged only
+func (s *Service) recordMentions(t entity.Task, oldText, newText string, userID uint) {
+	previous := make(map[string]bool)
+	for _, username := range entity.ParseMentions(oldText) {
+		previous[username] = true
+	}
+
+	mentions := make([]entity.Mention, 0)
+	for _, username := range entity.ParseMentions(newText) {
+		if previous[username] {
+			continue
+		}
+
+		user, err := s.userRepository.FindByUsername(username)
+		if err != nil {
+			s.logger.Debug("mentioned user not found", "username", username, "error", err)
+			continue
+		}
+
+		if user.ID == userID {
+			continue
+		}
+
+		mentions = append(mentions, entity.Mention{
+			TaskID:      t.ID,
+			UserID:      user.ID,
+			MentionedBy: userRef(userID),
+		})
+	}
+
+	if len(mentions) == 0 {
+		return
+	}
+
+	if err := s.repository.CreateMentions(mentions); err != nil {
+		s.logger.Error("error recording mentions", "task_id", t.ID, "error", err)
+		return
+	}
+
+	ctx := context.Background()
+	for _, m := range mentions {
+		err := s.notifier.Notify(ctx, notifier.Notification{
+			UserID:  m.UserID,
+			Subject: "You were mentioned",
+			Message: fmt.Sprintf("You were mentioned in task #%d \"%s\"", t.ID, t.Summary),
+		})
+		if err != nil {
+			s.logger.Error("failed to notify mentioned user", "task_id", t.ID, "user_id", m.UserID, "error", err)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	projectMocks "task_mng/domain/project/mocks"
+	"task_mng/domain/task/entity"
+	"task_mng/domain/task/mocks"
+	userEntity "task_mng/domain/user/entity"
+	userMocks "task_mng/domain/user/mocks"
+	"task_mng/pkg/notifier"
+	notifierMocks "task_mng/pkg/notifier/mocks"
+	redisMocks "task_mng/pkg/redis/mocks"
+	storageMocks "task_mng/pkg/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestRecordMentions_OnlyNewMentions(t *testing.T) {
+	mockRepo := new(mocks.MockTaskRepository)
+	mockUserRepo := new(userMocks.MockUserRepository)
+	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
+
+	var sent []notifier.Notification
+	notifierMock := &notifierMocks.MockNotifier{
+		NotifyFunc: func(ctx context.Context, notification notifier.Notification) error {
+			sent = append(sent, notification)
+			return nil
+		},
+	}
+	service := New(mockRepo, &redisMocks.MockRedisClient{}, mockUserRepo, notifierMock, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
+
+	mockUserRepo.On("FindByUsername", "bob").Return(userEntity.User{Model: gorm.Model{ID: 5}, Username: "bob"}, nil)
+	mockUserRepo.On("FindByUsername", "ghost").Return(userEntity.User{}, fmt.Errorf("record not found"))
+	mockUserRepo.On("FindByUsername", "me").Return(userEntity.User{Model: gorm.Model{ID: 1}, Username: "me"}, nil)
+	mockRepo.On("CreateMentions", []entity.Mention{{TaskID: 3, UserID: 5, MentionedBy: userRef(1)}}).Return(nil)
+
+	task := entity.Task{Model: gorm.Model{ID: 3}, Summary: "Deploy"}
+	service.recordMentions(task, "ask @alice", "ask @alice and @bob, not @ghost or @me", 1)
+
+	assert.Len(t, sent, 1)
+	assert.Equal(t, uint(5), sent[0].UserID)
+	mockRepo.AssertExpectations(t)
+	mockUserRepo.AssertNotCalled(t, "FindByUsername", "alice")
+}
+
+func TestMentions_Success(t *testing.T) {
+	mockRepo := new(mocks.MockTaskRepository)
+	mockUserRepo := new(userMocks.MockUserRepository)
+	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
+	service := New(mockRepo, &redisMocks.MockRedisClient{}, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
+
+	mockRepo.On("FindMentions", uint(5), 1, 10).Return([]entity.Mention{
+		{ID: 1, TaskID: 3, UserID: 5, MentionedBy: userRef(1)},
+	}, int64(1), nil)
+	mockRepo.On("FindByIDs", []uint{3}).Return([]entity.Task{{Model: gorm.Model{ID: 3}, Summary: "Deploy"}}, nil)
+	mockUserRepo.On("FindByIDs", []uint{1}).Return([]userEntity.User{{Model: gorm.Model{ID: 1}, Username: "admin"}}, nil)
+
+	result, err := service.Mentions(5, 1, 10)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Mentions, 1)
+	assert.Equal(t, "Deploy", result.Mentions[0].Task.Summary)
+	assert.Equal(t, "admin", result.Mentions[0].MentionedBy.Username)
+	assert.Equal(t, 1, result.Meta.Total)
+}
@@ -0,0 +1,250 @@
+package task
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"task_mng/domain/task/entity"
+	"task_mng/pkg/apperror"
+	"time"
+)
+
+// ********************* Patch *********************
+
+// PatchRequest is a JSON merge patch (RFC 7396) of a task
+type PatchRequest struct {
+	// Members of the patch document, an omitted member is left unchanged and a null member removes or resets it
+	Patch map[string]json.RawMessage
+	// Version the client last read, filled from the If-Match header by the handler, nil skips the check
+	Version *uint
+}
+
+// Patch changes only the fields present in the merge patch and records history for the fields that actually changed
+// Status, sprint and rank are left to the transition, sprint and board endpoints
+func (s *Service) Patch(id string, req *PatchRequest, userID uint) error {
+	t, err := s.findTask(id)
+	if err != nil {
+		return err
+	}
+
+	if req.Version != nil && *req.Version != t.Version {
+		return apperror.PreconditionFailed("task_version_conflict")
+	}
+
+	keys := make([]string, 0, len(req.Patch))
+	for key := range req.Patch {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	old := t
+	for _, key := range keys {
+		if err := s.applyPatch(&t, key, req.Patch[key]); err != nil {
+			return err
+		}
+	}
+
+	entries := changedFields(old, t, userID)
+	if len(entries) == 0 {
+		return nil
+	}
+
+	t.UpdatedBy = userRef(userID)
+	err = s.save(t)
+	if err != nil {
+		return err
+	}
+
+	s.recordHistory(entries...)
+
+	if t.Assignee != old.Assignee {
+		s.watch(t.ID, t.Assignee)
+	}
+
+	s.recordMentions(t, old.Description, t.Description, userID)
+
+	s.notifyWatchers(t, userID, "Task updated",
+		fmt.Sprintf("Task #%d \"%s\" was updated", t.ID, t.Summary))
+
+	// Invalidate cache after patching a task
+	s.invalidateTasksCache()
+
+	return nil
+}
+
+// applyPatch validates a single member of a merge patch and applies it to the task
+func (s *Service) applyPatch(t *entity.Task, key string, raw json.RawMessage) error {
+	null := string(raw) == "null"
+
+	switch key {
+	case "summary":
+		var summary string
+		if null || json.Unmarshal(raw, &summary) != nil || strings.TrimSpace(summary) == "" {
+			return apperror.Validation("summary_is_required")
+		}
+		t.Summary = summary
+
+	case "description":
+		var description string
+		if err := json.Unmarshal(raw, &description); err != nil {
+			return apperror.Validation("invalid_description")
+		}
+		t.Description = description
+
+	case "assignee":
+		var username string
+		if null || json.Unmarshal(raw, &username) != nil || username == "" {
+			return apperror.Validation("assignee_is_required")
+		}
+		user, err := s.userRepository.FindByUsername(username)
+		if err != nil {
+			s.logger.Error("error finding user", "error", err)
+			return apperror.Validation("can't find assignee user")
+		}
+		t.Assignee = user.ID
+
+	case "reporter":
+		if null {
+			t.Reporter = nil
+			return nil
+		}
+		var username string
+		if err := json.Unmarshal(raw, &username); err != nil {
+			return apperror.Validation("invalid_reporter")
+		}
+		user, err := s.userRepository.FindByUsername(username)
+		if err != nil {
+			s.logger.Error("error finding user", "error", err)
+			return apperror.Validation("can't find reporter user")
+		}
+		t.Reporter = &user.ID
+
+	case "priority":
+		var priority entity.Priority
+		if null || json.Unmarshal(raw, &priority) != nil || !priority.IsValid() {
+			return apperror.Validation("invalid_priority")
+		}
+		t.Priority = priority
+
+	case "due_date":
+		var dueDate time.Time
+		if !null && json.Unmarshal(raw, &dueDate) != nil {
+			return apperror.Validation("invalid_due_date")
+		}
+		t.DueDate = dueDate
+
+	case "labels":
+		var labels []string
+		if err := json.Unmarshal(raw, &labels); err != nil {
+			return apperror.Validation("invalid_labels")
+		}
+		t.Labels = labels
+
+	case "original_estimate", "remaining_estimate":
+		var estimate int
+		if err := json.Unmarshal(raw, &estimate); err != nil || estimate < 0 {
+			return apperror.Validation("invalid_estimate")
+		}
+		if key == "original_estimate" {
+			t.OriginalEstimate = estimate
+		} else {
+			t.RemainingEstimate = estimate
+		}
+
+	case "custom_fields":
+		// Custom fields merge like any nested object of a merge patch, null clears them all
+		var changes map[string]interface{}
+		if null {
+			changes = make(map[string]interface{}, len(t.CustomFields))
+			for field := range t.CustomFields {
+				changes[field] = nil
+			}
+		} else if err := json.Unmarshal(raw, &changes); err != nil {
+			return apperror.Validation("invalid_custom_field_value")
+		}
+
+		if len(changes) == 0 {
+			return nil
+		}
+		if t.ProjectID == nil {
+			return apperror.Validation("project_is_required_for_custom_fields")
+		}
+
+		values, err := s.customFields(*t.ProjectID, t.CustomFields, changes, false)
+		if err != nil {
+			return err
+		}
+		t.CustomFields = values
+
+	default:
+		s.logger.Info("unknown patch field", "field", key)
+		return apperror.Validation("unknown_field")
+	}
+
+	return nil
+}
+
+// changedFields returns history entries for the patchable fields that differ between the two versions of a task
+func changedFields(old, updated entity.Task, userID uint) []entity.History {
+	before := historyValues(old)
+	after := historyValues(updated)
+
+	fields := make([]string, 0, len(after))
+	for field := range before {
+		fields = append(fields, field)
+	}
+	for field := range after {
+		if _, ok := before[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	entries := make([]entity.History, 0)
+	for _, field := range fields {
+		if before[field] != after[field] {
+			entries = append(entries, entity.History{
+				TaskID:   updated.ID,
+				Field:    field,
+				OldValue: before[field],
+				NewValue: after[field],
+				UserID:   userRef(userID),
+			})
+		}
+	}
+	return entries
+}
+
+// historyValues returns the patchable fields of a task in the text form stored in the history
+func historyValues(t entity.Task) map[string]string {
+	reporter := ""
+	if t.Reporter != nil {
+		reporter = strconv.FormatUint(uint64(*t.Reporter), 10)
+	}
+
+	dueDate := ""
+	if !t.DueDate.IsZero() {
+		dueDate = t.DueDate.UTC().Format(time.RFC3339)
+	}
+
+	values := map[string]string{
+		entity.HistoryFieldSummary:           t.Summary,
+		entity.HistoryFieldDescription:       t.Description,
+		entity.HistoryFieldAssignee:          strconv.FormatUint(uint64(t.Assignee), 10),
+		entity.HistoryFieldReporter:          reporter,
+		entity.HistoryFieldPriority:          string(t.Priority),
+		entity.HistoryFieldDueDate:           dueDate,
+		entity.HistoryFieldLabels:            strings.Join(t.Labels, ","),
+		entity.HistoryFieldOriginalEstimate:  strconv.Itoa(t.OriginalEstimate),
+		entity.HistoryFieldRemainingEstimate: strconv.Itoa(t.RemainingEstimate),
+	}
+
+	for key, value := range t.CustomFields {
+		encoded, _ := json.Marshal(value)
+		values[entity.HistoryFieldCustomFieldPrefix+key] = string(encoded)
+	}
+
+	return values
+}
@@ -0,0 +1,103 @@
+package task
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"task_mng/domain/task/entity"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func mergePatch(t *testing.T, document string) map[string]json.RawMessage {
+	var patch map[string]json.RawMessage
+	assert.NoError(t, json.Unmarshal([]byte(document), &patch))
+	return patch
+}
+
+func TestPatch_RecordsOnlyChangedFields(t *testing.T) {
+	service, mockRepo := newBoardTestService()
+
+	dueDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockRepo.On("FindByID", uint(1)).Return(entity.Task{
+		Model:    gorm.Model{ID: 1},
+		Summary:  "Old summary",
+		Assignee: 2,
+		Priority: entity.PriorityMedium,
+		DueDate:  dueDate,
+		Labels:   []string{"backend"},
+	}, nil)
+	mockRepo.On("Update", mock.MatchedBy(func(t entity.Task) bool {
+		return t.Summary == "Old summary" &&
+			t.Priority == entity.PriorityMedium &&
+			t.DueDate.IsZero() &&
+			len(t.Labels) == 1 &&
+			*t.UpdatedBy == 5
+	})).Return(nil)
+	mockRepo.On("CreateHistory", []entity.History{{
+		TaskID:   1,
+		Field:    entity.HistoryFieldDueDate,
+		OldValue: "2025-01-01T00:00:00Z",
+		NewValue: "",
+		UserID:   userRef(5),
+	}}).Return(nil)
+	mockRepo.On("FindWatchers", uint(1)).Return([]entity.Watcher{}, nil)
+
+	err := service.Patch("1", &PatchRequest{
+		Patch: mergePatch(t, `{"summary": "Old summary", "priority": "medium", "due_date": null}`),
+	}, 5)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPatch_NoChanges(t *testing.T) {
+	service, mockRepo := newBoardTestService()
+
+	mockRepo.On("FindByID", uint(1)).Return(entity.Task{Model: gorm.Model{ID: 1}, Summary: "Summary"}, nil)
+
+	err := service.Patch("1", &PatchRequest{Patch: mergePatch(t, `{"summary": "Summary"}`)}, 5)
+
+	assert.NoError(t, err)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestPatch_InvalidFields(t *testing.T) {
+	cases := map[string]string{
+		`{"priority": null}`:                      "invalid_priority",
+		`{"priority": "urgent"}`:                  "invalid_priority",
+		`{"summary": ""}`:                         "summary_is_required",
+		`{"assignee": null}`:                      "assignee_is_required",
+		`{"original_estimate": -1}`:               "invalid_estimate",
+		`{"due_date": "tomorrow"}`:                "invalid_due_date",
+		`{"status": "Done"}`:                      "unknown_field",
+		`{"custom_fields": {"customer": "ACME"}}`: "project_is_required_for_custom_fields",
+	}
+
+	for document, expected := range cases {
+		service, mockRepo := newBoardTestService()
+		mockRepo.On("FindByID", uint(1)).Return(entity.Task{Model: gorm.Model{ID: 1}, Summary: "Summary"}, nil)
+
+		err := service.Patch("1", &PatchRequest{Patch: mergePatch(t, document)}, 5)
+
+		assert.Error(t, err, document)
+		assert.Equal(t, expected, err.Error(), document)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything)
+	}
+}
+
+func TestPatch_StaleVersion(t *testing.T) {
+	service, mockRepo := newBoardTestService()
+
+	version := uint(1)
+	mockRepo.On("FindByID", uint(1)).Return(entity.Task{Model: gorm.Model{ID: 1}, Version: 2}, nil)
+
+	err := service.Patch("1", &PatchRequest{Patch: mergePatch(t, `{"summary": "New"}`), Version: &version}, 5)
+
+	assert.Error(t, err)
+	assert.Equal(t, "task_version_conflict", err.Error())
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
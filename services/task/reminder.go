@@ -0,0 +1,141 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"task_mng/domain/task/entity"
+	"task_mng/pkg/notifier"
+	"time"
+)
+
+const (
+	reminderKeyPrefix   = "tasks:reminder"
+	escalationKeyPrefix = "tasks:escalation"
+	overdueRemindEvery  = 24 * time.Hour
+	// escalationRetention is how long after its due date a task is remembered as escalated,
+	// tasks overdue for longer aren't escalated anymore
+	escalationRetention = 365 * 24 * time.Hour
+)
+
+// ReminderConfig controls due-date reminders and overdue escalation
+type ReminderConfig struct {
+	// Window is how long before the due date assignees get reminded
+	Window time.Duration
+	// EscalateAfter is how long a task has to be overdue before its priority is bumped, zero disables escalation
+	EscalateAfter time.Duration
+}
+
+// ********************* Due Date Reminders *********************
+
+// RemindDueTasks notifies assignees and watchers about tasks due within the configured window or overdue,
+// and bumps the priority of tasks that have been overdue for longer than EscalateAfter
+func (s *Service) RemindDueTasks(ctx context.Context, cfg ReminderConfig) error {
+	now := time.Now().UTC()
+
+	tasks, err := s.repository.FindDue(now.Add(cfg.Window))
+	if err != nil {
+		s.logger.Error("error finding due tasks", "error", err)
+		return err
+	}
+
+	escalated := false
+	for _, t := range tasks {
+		if t.DueDate.After(now) {
+			s.remind(ctx, t, "due_soon", "Task due soon", cfg.Window,
+				fmt.Sprintf("Task #%d \"%s\" is due at %s", t.ID, t.Summary, t.DueDate.Format(time.RFC3339)))
+			continue
+		}
+
+		s.remind(ctx, t, "overdue", "Task overdue", overdueRemindEvery,
+			fmt.Sprintf("Task #%d \"%s\" was due at %s and is overdue", t.ID, t.Summary, t.DueDate.Format(time.RFC3339)))
+
+		if cfg.EscalateAfter > 0 && now.Sub(t.DueDate) >= cfg.EscalateAfter && s.escalate(ctx, t, now) {
+			escalated = true
+		}
+	}
+
+	if escalated {
+		// Invalidate cache after escalating task priorities
+		s.invalidateTasksCache()
+	}
+
+	return nil
+}
+
+// remind sends a reminder to the assignee and watchers unless the same reminder was already sent within the period
+func (s *Service) remind(ctx context.Context, t entity.Task, kind, subject string, period time.Duration, message string) {
+	key := fmt.Sprintf("%s:%d:%s:%d", reminderKeyPrefix, t.ID, kind, t.DueDate.Unix())
+
+	sent, err := s.redis.SetNX(ctx, key, "1", period)
+	if err != nil {
+		s.logger.Warn("Failed to check reminder state", "task_id", t.ID, "error", err)
+		return
+	}
+
+	if !sent {
+		return
+	}
+
+	delivered := false
+	for _, userID := range s.audience(t) {
+		err = s.notifier.Notify(ctx, notifier.Notification{
+			UserID:  userID,
+			Subject: subject,
+			Message: message,
+		})
+		if err != nil {
+			s.logger.Error("failed to send reminder", "task_id", t.ID, "user_id", userID, "error", err)
+			continue
+		}
+		delivered = true
+	}
+
+	if !delivered {
+		// Nobody got the reminder, so let the next run try again
+		_ = s.redis.Del(ctx, key)
+	}
+}
+
+// escalate bumps the task priority one level, once per due date
+// The marker is kept until the retention ends, so a task is escalated again only when its due date changes
+func (s *Service) escalate(ctx context.Context, t entity.Task, now time.Time) bool {
+	ttl := t.DueDate.Add(escalationRetention).Sub(now)
+	if t.Priority == entity.PriorityHighest || ttl <= 0 {
+		return false
+	}
+
+	key := fmt.Sprintf("%s:%d:%d", escalationKeyPrefix, t.ID, t.DueDate.Unix())
+
+	acquired, err := s.redis.SetNX(ctx, key, "1", ttl)
+	if err != nil {
+		s.logger.Warn("Failed to check escalation state", "task_id", t.ID, "error", err)
+		return false
+	}
+
+	if !acquired {
+		return false
+	}
+
+	previous := t.Priority
+	t.Priority = t.Priority.Escalate()
+
+	// Only the priority is written, the task may have been edited since it was read
+	err = s.repository.UpdateFields(t.ID, map[string]interface{}{"priority": t.Priority})
+	if err != nil {
+		s.logger.Error("error escalating task priority", "task_id", t.ID, "error", err)
+		_ = s.redis.Del(ctx, key)
+		return false
+	}
+
+	// Escalations aren't made by a user
+	s.recordHistory(entity.History{
+		TaskID:   t.ID,
+		Field:    entity.HistoryFieldPriority,
+		OldValue: string(previous),
+		NewValue: string(t.Priority),
+	})
+
+	s.logger.Info("Task priority escalated", "task_id", t.ID, "from", previous, "to", t.Priority)
+
+	return true
+}
@@ -0,0 +1,167 @@
+package task
+
+import (
+	"context"
+	"strings"
+	projectMocks "task_mng/domain/project/mocks"
+	"task_mng/domain/task/entity"
+	"task_mng/domain/task/mocks"
+	userMocks "task_mng/domain/user/mocks"
+	"task_mng/pkg/notifier"
+	notifierMocks "task_mng/pkg/notifier/mocks"
+	redisMocks "task_mng/pkg/redis/mocks"
+	storageMocks "task_mng/pkg/storage/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestRemindDueTasks_DueSoon(t *testing.T) {
+	mockRepo := new(mocks.MockTaskRepository)
+	redisMock := &redisMocks.MockRedisClient{}
+	mockUserRepo := new(userMocks.MockUserRepository)
+
+	var sent []notifier.Notification
+	notifierMock := &notifierMocks.MockNotifier{
+		NotifyFunc: func(ctx context.Context, notification notifier.Notification) error {
+			sent = append(sent, notification)
+			return nil
+		},
+	}
+
+	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
+
+	service := New(mockRepo, redisMock, mockUserRepo, notifierMock, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
+
+	mockRepo.On("FindDue", mock.Anything).Return([]entity.Task{
+		{
+			Model:    gorm.Model{ID: 1},
+			Summary:  "Due Soon",
+			Assignee: 7,
+			Status:   entity.StatusTodo,
+			Priority: entity.PriorityMedium,
+			DueDate:  time.Now().Add(time.Hour),
+		},
+	}, nil)
+
+	mockRepo.On("FindWatchers", uint(1)).Return([]entity.Watcher{
+		{TaskID: 1, UserID: 7},
+		{TaskID: 1, UserID: 9},
+	}, nil)
+
+	err := service.RemindDueTasks(context.Background(), ReminderConfig{Window: 24 * time.Hour})
+
+	assert.NoError(t, err)
+	assert.Len(t, sent, 2)
+	assert.Equal(t, uint(7), sent[0].UserID)
+	assert.Equal(t, uint(9), sent[1].UserID)
+	assert.Equal(t, "Task due soon", sent[0].Subject)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRemindDueTasks_AlreadyReminded(t *testing.T) {
+	mockRepo := new(mocks.MockTaskRepository)
+	redisMock := &redisMocks.MockRedisClient{
+		SetNXFunc: func(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+			return false, nil
+		},
+	}
+	mockUserRepo := new(userMocks.MockUserRepository)
+
+	notified := false
+	notifierMock := &notifierMocks.MockNotifier{
+		NotifyFunc: func(ctx context.Context, notification notifier.Notification) error {
+			notified = true
+			return nil
+		},
+	}
+
+	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
+
+	service := New(mockRepo, redisMock, mockUserRepo, notifierMock, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
+
+	mockRepo.On("FindDue", mock.Anything).Return([]entity.Task{
+		{
+			Model:    gorm.Model{ID: 1},
+			Summary:  "Due Soon",
+			Assignee: 7,
+			Status:   entity.StatusTodo,
+			Priority: entity.PriorityMedium,
+			DueDate:  time.Now().Add(time.Hour),
+		},
+	}, nil)
+
+	err := service.RemindDueTasks(context.Background(), ReminderConfig{Window: 24 * time.Hour})
+
+	assert.NoError(t, err)
+	assert.False(t, notified, "Reminder should not be sent twice")
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRemindDueTasks_EscalatesOverdue(t *testing.T) {
+	mockRepo := new(mocks.MockTaskRepository)
+	incrCalled := false
+	var escalationTTL time.Duration
+	redisMock := &redisMocks.MockRedisClient{
+		SetNXFunc: func(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+			if strings.HasPrefix(key, escalationKeyPrefix) {
+				escalationTTL = expiration
+			}
+			return true, nil
+		},
+		IncrFunc: func(ctx context.Context, key string) error {
+			incrCalled = true
+			return nil
+		},
+	}
+	mockUserRepo := new(userMocks.MockUserRepository)
+
+	var sent []notifier.Notification
+	notifierMock := &notifierMocks.MockNotifier{
+		NotifyFunc: func(ctx context.Context, notification notifier.Notification) error {
+			sent = append(sent, notification)
+			return nil
+		},
+	}
+
+	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
+
+	service := New(mockRepo, redisMock, mockUserRepo, notifierMock, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
+
+	mockRepo.On("FindDue", mock.Anything).Return([]entity.Task{
+		{
+			Model:    gorm.Model{ID: 1},
+			Summary:  "Overdue",
+			Assignee: 7,
+			Status:   entity.StatusInProgress,
+			Priority: entity.PriorityHigh,
+			DueDate:  time.Now().Add(-4 * 24 * time.Hour),
+		},
+	}, nil)
+
+	mockRepo.On("FindWatchers", uint(1)).Return([]entity.Watcher{}, nil)
+
+	mockRepo.On("UpdateFields", uint(1), map[string]interface{}{"priority": entity.PriorityHighest}).Return(nil)
+	mockRepo.On("CreateHistory", []entity.History{{
+		TaskID:   1,
+		Field:    entity.HistoryFieldPriority,
+		OldValue: string(entity.PriorityHigh),
+		NewValue: string(entity.PriorityHighest),
+	}}).Return(nil)
+
+	err := service.RemindDueTasks(context.Background(), ReminderConfig{
+		Window:        24 * time.Hour,
+		EscalateAfter: 3 * 24 * time.Hour,
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, sent, 1)
+	assert.Equal(t, "Task overdue", sent[0].Subject)
+	assert.True(t, incrCalled, "Cache should be invalidated after escalation")
+	// The task isn't escalated again while its due date is unchanged
+	assert.InDelta(t, (escalationRetention - 4*24*time.Hour).Seconds(), escalationTTL.Seconds(), 60)
+	mockRepo.AssertExpectations(t)
+}
@@ -7,13 +7,17 @@ import (
 	"fmt"
 	"log/slog"
 	"strconv"
+	"task_mng/domain/project"
 	"task_mng/domain/task"
 	"task_mng/domain/task/aggregate"
 	"task_mng/domain/task/entity"
 	"task_mng/domain/user"
+	"task_mng/pkg/apperror"
 	"task_mng/pkg/metrics"
+	"task_mng/pkg/notifier"
 	"task_mng/pkg/redis"
 	"task_mng/pkg/response"
+	"task_mng/pkg/storage"
 	"time"
 
 	goredis "github.com/redis/go-redis/v9"
@@ -21,14 +25,26 @@ import (
 )
 
 type Service struct {
-	repository     task.Repository
-	logger         *slog.Logger
-	redis          redis.RedisClient
-	userRepository user.Repository
+	repository        task.Repository
+	logger            *slog.Logger
+	redis             redis.RedisClient
+	userRepository    user.Repository
+	notifier          notifier.Notifier
+	projectRepository project.Repository
+	// storage holds the attachment files, they are removed when their task is purged
+	storage storage.Storage
 }
 
-func New(repository task.Repository, redis redis.RedisClient, userRepository user.Repository) *Service {
-	s := &Service{repository: repository, logger: slog.Default(), redis: redis, userRepository: userRepository}
+func New(repository task.Repository, redis redis.RedisClient, userRepository user.Repository, notifier notifier.Notifier, projectRepository project.Repository, storage storage.Storage) *Service {
+	s := &Service{
+		repository:        repository,
+		logger:            slog.Default(),
+		redis:             redis,
+		userRepository:    userRepository,
+		notifier:          notifier,
+		projectRepository: projectRepository,
+		storage:           storage,
+	}
 	// Initialize task count metrics on startup
 	s.updateTaskMetrics()
 	return s
@@ -40,11 +56,25 @@ type CreateRequest struct {
 	Summary     string           `json:"summary" valid:"required~summary_is_required" example:"Implement task management system"`
 	Description string           `json:"description" example:"Implement task management system"`
 	Assignee    string           `json:"assignee" valid:"required~assignee_is_required" example:"admin"`
+	Reporter    *string          `json:"reporter" example:"admin"` // username, defaults to the creating user
 	Priority    *entity.Priority `json:"priority" valid:"optional,in(lowest|low|medium|high|highest)~invalid_priority" example:"medium"`
 	DueDate     *time.Time       `json:"due_date" example:"2025-01-01T00:00:00Z"`
+	Labels      []string         `json:"labels" example:"backend"`
+	// Estimate in minutes, the remaining estimate starts with the same value
+	OriginalEstimate *int `json:"original_estimate" example:"480"`
+	// Custom field values are validated against the field definitions of the project
+	ProjectID    *uint                  `json:"project_id" example:"1"`
+	CustomFields map[string]interface{} `json:"custom_fields"`
+}
+
+// Create creates a task, the creating user and the assignee start watching it
+func (s *Service) Create(req *CreateRequest, userID uint) error {
+	_, err := s.CreateEntity(req, userID)
+	return err
 }
 
-func (s *Service) Create(req *CreateRequest) error {
+// CreateEntity creates a task like Create and returns the stored entity
+func (s *Service) CreateEntity(req *CreateRequest, userID uint) (*entity.Task, error) {
 	dueDate := time.Time{}
 	if req.DueDate != nil {
 		dueDate = *req.DueDate
@@ -55,27 +85,92 @@ func (s *Service) Create(req *CreateRequest) error {
 		priority = *req.Priority
 	}
 
+	estimate := 0
+	if req.OriginalEstimate != nil {
+		if *req.OriginalEstimate < 0 {
+			return nil, apperror.Validation("invalid_estimate")
+		}
+		estimate = *req.OriginalEstimate
+	}
+
 	// check assignee if exists
 	user, err := s.userRepository.FindByUsername(req.Assignee)
 	if err != nil {
 		s.logger.Error("error finding user", "error", err)
-		return fmt.Errorf("can't find assignee user")
+		return nil, apperror.Validation("can't find assignee user")
+	}
+
+	var customFields map[string]interface{}
+	if req.ProjectID != nil {
+		customFields, err = s.customFields(*req.ProjectID, nil, req.CustomFields, true)
+		if err != nil {
+			return nil, err
+		}
+	} else if len(req.CustomFields) > 0 {
+		return nil, apperror.Validation("project_is_required_for_custom_fields")
+	}
+
+	reporter := userRef(userID)
+	if req.Reporter != nil {
+		reporterUser, err := s.userRepository.FindByUsername(*req.Reporter)
+		if err != nil {
+			s.logger.Error("error finding user", "error", err)
+			return nil, apperror.Validation("can't find reporter user")
+		}
+		reporter = &reporterUser.ID
 	}
 
 	e := &entity.Task{
 		Summary:     req.Summary,
 		Description: req.Description,
 		Assignee:    user.ID,
+		Reporter:    reporter,
+		CreatedBy:   userRef(userID),
+		UpdatedBy:   userRef(userID),
 		Status:      entity.StatusTodo,
 		Priority:    priority,
 		DueDate:     dueDate,
+		Labels:      req.Labels,
+
+		ProjectID:    req.ProjectID,
+		CustomFields: customFields,
+
+		OriginalEstimate:  estimate,
+		RemainingEstimate: estimate,
 	}
 
 	err = s.repository.Create(e)
 	if err != nil {
+		return nil, err
+	}
+
+	s.watch(e.ID, userID, e.Assignee)
+
+	s.recordMentions(*e, "", e.Description, userID)
+
+	// Invalidate cache after creating a new task
+	s.invalidateTasksCache()
+
+	// Update task count metrics
+	s.updateTaskMetrics()
+
+	return e, nil
+}
+
+// ********************* Create Occurrence *********************
+
+// CreateOccurrence stores a task generated by a recurrence rule
+func (s *Service) CreateOccurrence(e *entity.Task) error {
+	e.Status = entity.StatusTodo
+
+	err := s.repository.Create(e)
+	if err != nil {
+		s.logger.Error("error creating task occurrence", "error", err)
 		return err
 	}
 
+	s.watch(e.ID, e.Assignee)
+
 	// Invalidate cache after creating a new task
 	s.invalidateTasksCache()
 
@@ -90,44 +185,106 @@ type UpdateRequest struct {
 	Summary     string          `json:"summary" valid:"required~summary_is_required" example:"Implement task management system"`
 	Description string          `json:"description" example:"Implement task management system"`
 	Assignee    string          `json:"assignee" valid:"required~assignee_is_required" example:"admin"`
+	Reporter    *string         `json:"reporter" example:"admin"` // username, left unchanged when omitted
 	Priority    entity.Priority `json:"priority" valid:"optional,in(lowest|low|medium|high|highest)~invalid_priority" example:"medium"`
 	DueDate     time.Time       `json:"due_date" example:"2025-01-01T00:00:00Z"`
+	Labels      []string        `json:"labels" example:"backend"`
+	// Estimates in minutes, omitted estimates are left unchanged
+	OriginalEstimate  *int `json:"original_estimate" example:"480"`
+	RemainingEstimate *int `json:"remaining_estimate" example:"240"`
+	// Only the given custom fields are changed, null removes a value
+	CustomFields map[string]interface{} `json:"custom_fields"`
+	// Version the client last read, filled from the If-Match header by the handler, nil skips the check
+	Version *uint `json:"-"`
 }
 
-func (s *Service) Update(req *UpdateRequest, id string) error {
+// Update replaces the task, a version that doesn't match the stored one fails with task_version_conflict
+func (s *Service) Update(req *UpdateRequest, id string, userID uint) error {
 	uintID, err := strconv.ParseUint(id, 10, 32)
 	if err != nil {
 		s.logger.Error("error parsing id", "error", err)
-		return fmt.Errorf("invalid_id")
+		return apperror.BadRequest("invalid_id")
 	}
 
 	task, err := s.repository.FindByID(uint(uintID))
 	if err != nil {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
 			s.logger.Error("error finding task", "error", err)
-			return fmt.Errorf("internal_server_error")
+			return apperror.Internal(err)
 		}
 		s.logger.Error("task not found", "error", err)
-		return fmt.Errorf("task_not_found")
+		return apperror.NotFound("task_not_found")
+	}
+
+	if req.Version != nil && *req.Version != task.Version {
+		return apperror.PreconditionFailed("task_version_conflict")
 	}
 
 	user, err := s.userRepository.FindByUsername(req.Assignee)
 	if err != nil {
 		s.logger.Error("error finding user", "error", err)
-		return fmt.Errorf("can't find assignee user")
+		return apperror.Validation("can't find assignee user")
+	}
+
+	if req.Reporter != nil {
+		reporterUser, err := s.userRepository.FindByUsername(*req.Reporter)
+		if err != nil {
+			s.logger.Error("error finding user", "error", err)
+			return apperror.Validation("can't find reporter user")
+		}
+		task.Reporter = &reporterUser.ID
+	}
+
+	if len(req.CustomFields) > 0 {
+		if task.ProjectID == nil {
+			return apperror.Validation("project_is_required_for_custom_fields")
+		}
+
+		task.CustomFields, err = s.customFields(*task.ProjectID, task.CustomFields, req.CustomFields, false)
+		if err != nil {
+			return err
+		}
 	}
 
+	reassigned := task.Assignee != user.ID
+	oldDescription := task.Description
+
 	task.Summary = req.Summary
 	task.Description = req.Description
 	task.Assignee = user.ID
 	task.Priority = req.Priority
 	task.DueDate = req.DueDate
+	task.Labels = req.Labels
+	task.UpdatedBy = userRef(userID)
+
+	if req.OriginalEstimate != nil {
+		if *req.OriginalEstimate < 0 {
+			return apperror.Validation("invalid_estimate")
+		}
+		task.OriginalEstimate = *req.OriginalEstimate
+	}
+
+	if req.RemainingEstimate != nil {
+		if *req.RemainingEstimate < 0 {
+			return apperror.Validation("invalid_estimate")
+		}
+		task.RemainingEstimate = *req.RemainingEstimate
+	}
 
-	err = s.repository.Update(task)
+	err = s.save(task)
 	if err != nil {
 		return err
 	}
 
+	if reassigned {
+		s.watch(task.ID, task.Assignee)
+	}
+
+	s.recordMentions(task, oldDescription, task.Description, userID)
+
+	s.notifyWatchers(task, userID, "Task updated",
+		fmt.Sprintf("Task #%d \"%s\" was updated", task.ID, task.Summary))
+
 	// Invalidate cache after updating a task
 	s.invalidateTasksCache()
 
@@ -142,36 +299,33 @@ func (s *Service) FindByID(id string) (*aggregate.TaskResponse, error) {
 	uintID, err := strconv.ParseUint(id, 10, 32)
 	if err != nil {
 		s.logger.Error("error parsing id", "error", err)
-		return nil, fmt.Errorf("invalid_id")
+		return nil, apperror.BadRequest("invalid_id")
 	}
 
 	t, err := s.repository.FindByID(uint(uintID))
 	if err != nil {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
 			s.logger.Error("error finding task", "error", err)
-			return nil, fmt.Errorf("internal_server_error")
+			return nil, apperror.Internal(err)
 		}
 		s.logger.Error("task not found", "error", err)
-		return nil, fmt.Errorf("task_not_found")
-	}
-
-	// Get assignee username
-	assigneeUsername := ""
-	user, err := s.userRepository.FindByID(t.Assignee)
-	if err != nil {
-		s.logger.Warn("assignee user not found", "assignee_id", t.Assignee, "error", err)
-	} else {
-		assigneeUsername = user.Username
+		return nil, apperror.NotFound("task_not_found")
 	}
 
-	return aggregate.NewTaskResponse(&t, assigneeUsername), nil
+	return aggregate.NewTaskResponse(&t, s.usernames([]entity.Task{t})), nil
 }
 
 // ********************* Find All *********************
 type FilterRequest struct {
 	Assignee *string          `form:"assignee"`
+	Reporter *string          `form:"reporter"`
 	Status   *entity.Status   `form:"status"`
 	Priority *entity.Priority `form:"priority"`
+	Project  *uint            `form:"project"`
+	// Archived tasks are left out unless asked for
+	IncludeArchived bool `form:"include_archived"`
+	// Custom field filters given as cf[key]=value, filled from the query map by the handler
+	CustomFields map[string]string `form:"-"`
 }
 
 func (s *Service) FindAll(req *FilterRequest, page, limit int) (*aggregate.TaskListResponse, error) {
@@ -209,40 +363,42 @@ func (s *Service) FindAll(req *FilterRequest, page, limit int) (*aggregate.TaskL
 		}
 	}
 
-	filter := &task.Filter{
-		Assignee: assignee,
-		Status:   req.Status,
-		Priority: req.Priority,
+	// An unknown reporter would otherwise drop the filter and list every task
+	var reporter *uint
+	if req.Reporter != nil {
+		user, err := s.userRepository.FindByUsername(*req.Reporter)
+		if err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				s.logger.Error("error finding reporter", "error", err)
+				return nil, apperror.Internal(err)
+			}
+			return nil, apperror.Validation("reporter_not_found").Wrap(err)
+		}
+		reporter = &user.ID
 	}
 
-	tasks, count, err := s.repository.FindAll(filter, page, limit)
+	customFields, err := s.customFieldFilter(req.Project, req.CustomFields)
 	if err != nil {
-		s.logger.Error("error finding tasks", "error", err)
 		return nil, err
 	}
 
-	assigneeIDs := make([]uint, 0)
-	assigneeIDMap := make(map[uint]bool)
-	for _, task := range tasks {
-		if !assigneeIDMap[task.Assignee] {
-			assigneeIDs = append(assigneeIDs, task.Assignee)
-			assigneeIDMap[task.Assignee] = true
-		}
+	filter := &task.Filter{
+		Assignee:        assignee,
+		Reporter:        reporter,
+		Project:         req.Project,
+		CustomFields:    customFields,
+		Status:          req.Status,
+		Priority:        req.Priority,
+		IncludeArchived: req.IncludeArchived,
 	}
 
-	assigneeUsernames := make(map[uint]string)
-	if len(assigneeIDs) > 0 {
-		users, err := s.userRepository.FindByIDs(assigneeIDs)
-		if err != nil {
-			s.logger.Warn("error finding assignee users", "error", err)
-		} else {
-			for _, user := range users {
-				assigneeUsernames[user.ID] = user.Username
-			}
-		}
+	tasks, count, err := s.repository.FindAll(filter, page, limit)
+	if err != nil {
+		s.logger.Error("error finding tasks", "error", err)
+		return nil, err
 	}
 
-	aggregatedTasks := aggregate.NewTaskListResponse(tasks, assigneeUsernames, page, limit, count, "")
+	aggregatedTasks := aggregate.NewTaskListResponse(tasks, s.usernames(tasks), page, limit, count, "")
 
 	if cacheKey != "" {
 		cachedResponse := cachedTasksResponse{
@@ -271,17 +427,17 @@ func (s *Service) Delete(id string) error {
 	uintID, err := strconv.ParseUint(id, 10, 32)
 	if err != nil {
 		s.logger.Error("error parsing id", "error", err)
-		return fmt.Errorf("invalid_id")
+		return apperror.BadRequest("invalid_id")
 	}
 
 	t, err := s.repository.FindByID(uint(uintID))
 	if err != nil {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
 			s.logger.Error("error finding task", "error", err)
-			return fmt.Errorf("internal_server_error")
+			return apperror.Internal(err)
 		}
 		s.logger.Error("task not found", "error", err)
-		return fmt.Errorf("task_not_found")
+		return apperror.NotFound("task_not_found")
 	}
 
 	err = s.repository.Delete(t)
@@ -304,29 +460,35 @@ type AssignRequest struct {
 	Assignee string `json:"assignee" valid:"required~assignee_is_required" example:"admin"`
 }
 
-func (s *Service) Assign(req *AssignRequest) error {
+func (s *Service) Assign(req *AssignRequest, userID uint) error {
 	user, err := s.userRepository.FindByUsername(req.Assignee)
 	if err != nil {
 		s.logger.Error("error finding user", "error", err)
-		return fmt.Errorf("can't find assignee user")
+		return apperror.Validation("can't find assignee user")
 	}
 
 	task, err := s.repository.FindByID(req.TaskID)
 	if err != nil {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
 			s.logger.Error("error finding task", "error", err)
-			return fmt.Errorf("internal_server_error")
+			return apperror.Internal(err)
 		}
 		s.logger.Error("task not found", "error", err)
-		return fmt.Errorf("task_not_found")
+		return apperror.NotFound("task_not_found")
 	}
 
 	task.Assignee = user.ID
-	err = s.repository.Update(task)
+	task.UpdatedBy = userRef(userID)
+	err = s.save(task)
 	if err != nil {
 		return err
 	}
 
+	s.watch(task.ID, task.Assignee)
+
+	s.notifyWatchers(task, userID, "Task assigned",
+		fmt.Sprintf("Task #%d \"%s\" was assigned to %s", task.ID, task.Summary, user.Username))
+
 	// Invalidate cache after assigning a task
 	s.invalidateTasksCache()
 
@@ -342,23 +504,51 @@ type StatusTransitionRequest struct {
 	Status entity.Status `json:"status" valid:"required~status_is_required,in(ToDo|InProgress|Done)~invalid_status" example:"InProgress"`
 }
 
-func (s *Service) StatusTransition(req *StatusTransitionRequest) error {
+func (s *Service) StatusTransition(req *StatusTransitionRequest, userID uint) error {
 	task, err := s.repository.FindByID(req.TaskID)
 	if err != nil {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
 			s.logger.Error("error finding task", "error", err)
-			return fmt.Errorf("internal_server_error")
+			return apperror.Internal(err)
 		}
 		s.logger.Error("task not found", "error", err)
-		return fmt.Errorf("task_not_found")
+		return apperror.NotFound("task_not_found")
+	}
+
+	oldStatus := task.Status
+	if oldStatus != req.Status {
+		if err := s.checkWipLimit(req.Status); err != nil {
+			return err
+		}
+
+		// A task entering a column goes to its end
+		rank, err := s.endRank(req.Status)
+		if err != nil {
+			return err
+		}
+		task.Rank = rank
+		task.CompletedAt = completedAt(req.Status)
 	}
 
 	task.Status = req.Status
-	err = s.repository.Update(task)
+	task.UpdatedBy = userRef(userID)
+	err = s.save(task)
 	if err != nil {
 		return err
 	}
 
+	if oldStatus != task.Status {
+		s.recordHistory(entity.History{
+			TaskID:   task.ID,
+			Field:    entity.HistoryFieldStatus,
+			OldValue: string(oldStatus),
+			NewValue: string(task.Status),
+			UserID:   userRef(userID),
+		})
+
+		s.notifyStatusChange(task, oldStatus, userID)
+	}
+
 	// Invalidate cache after transitioning task status
 	s.invalidateTasksCache()
 
@@ -368,6 +558,168 @@ func (s *Service) StatusTransition(req *StatusTransitionRequest) error {
 	return nil
 }
 
+// ********************* Checklist Progress *********************
+
+// UpdateChecklistProgress stores the checklist counters used for the completion ratio of a task
+func (s *Service) UpdateChecklistProgress(taskID uint, total, done int) error {
+	err := s.repository.UpdateFields(taskID, map[string]interface{}{
+		"checklist_total": total,
+		"checklist_done":  done,
+	})
+	if err != nil {
+		s.logger.Error("error updating checklist progress", "task_id", taskID, "error", err)
+		return apperror.Internal(err)
+	}
+
+	// Invalidate cache after changing the checklist progress
+	s.invalidateTasksCache()
+
+	return nil
+}
+
+// ********************* Time Tracking *********************
+
+// UpdateTimeTracking recomputes the logged time of a task from its work logs and reduces the remaining
+// estimate by the newly logged minutes, unless a new remaining estimate is given
+func (s *Service) UpdateTimeTracking(taskID uint, logged int, remaining *int) error {
+	err := s.repository.UpdateTimeTracking(taskID, logged, remaining)
+	if err != nil {
+		s.logger.Error("error updating time tracking", "task_id", taskID, "error", err)
+		return apperror.Internal(err)
+	}
+
+	// Invalidate cache after changing the logged time
+	s.invalidateTasksCache()
+
+	return nil
+}
+
+// ********************* Sprint *********************
+
+// SetSprint moves the tasks into a sprint, a nil sprint moves them back to the backlog
+func (s *Service) SetSprint(taskIDs []uint, sprintID *uint) error {
+	if len(taskIDs) == 0 {
+		return nil
+	}
+
+	tasks, err := s.repository.FindByIDs(taskIDs)
+	if err != nil {
+		s.logger.Error("error finding tasks", "error", err)
+		return apperror.Internal(err)
+	}
+
+	if len(tasks) != len(taskIDs) {
+		return apperror.NotFound("task_not_found")
+	}
+
+	entries := make([]entity.History, 0, len(tasks))
+	for _, t := range tasks {
+		if sprintValue(t.SprintID) == sprintValue(sprintID) {
+			continue
+		}
+
+		err := s.repository.UpdateFields(t.ID, map[string]interface{}{"sprint_id": sprintID})
+		if err != nil {
+			s.logger.Error("error updating task sprint", "task_id", t.ID, "error", err)
+			return apperror.Internal(err)
+		}
+
+		entries = append(entries, entity.History{
+			TaskID:   t.ID,
+			Field:    entity.HistoryFieldSprint,
+			OldValue: sprintValue(t.SprintID),
+			NewValue: sprintValue(sprintID),
+		})
+	}
+
+	s.recordHistory(entries...)
+
+	// Invalidate cache after moving tasks between sprints
+	s.invalidateTasksCache()
+
+	return nil
+}
+
+func sprintValue(sprintID *uint) string {
+	if sprintID == nil {
+		return ""
+	}
+	return strconv.FormatUint(uint64(*sprintID), 10)
+}
+
+// ********************* Helper: Notify Status Change *********************
+func (s *Service) notifyStatusChange(t entity.Task, oldStatus entity.Status, userID uint) {
+	s.notifyWatchers(t, userID, "Task status changed",
+		fmt.Sprintf("Task #%d \"%s\" moved from %s to %s", t.ID, t.Summary, oldStatus, t.Status))
+}
+
+// ********************* Helper: User Reference *********************
+
+// userRef returns the user id to store on a task, 0 stands for system changes and is stored as nil
+func userRef(userID uint) *uint {
+	if userID == 0 {
+		return nil
+	}
+	return &userID
+}
+
+// ********************* Helper: Usernames *********************
+
+// usernames looks up the users referenced by the tasks, missing users are left out
+func (s *Service) usernames(tasks []entity.Task) map[uint]string {
+	usernames := make(map[uint]string)
+
+	userIDs := aggregate.UserIDs(tasks)
+	if len(userIDs) == 0 {
+		return usernames
+	}
+
+	users, err := s.userRepository.FindByIDs(userIDs)
+	if err != nil {
+		s.logger.Warn("error finding task users", "error", err)
+		return usernames
+	}
+
+	for _, user := range users {
+		usernames[user.ID] = user.Username
+	}
+	return usernames
+}
+
+// ********************* Helper: Save *********************
+
+// save stores a task changed after it was read, a concurrent change in between fails with task_version_conflict
+func (s *Service) save(t entity.Task) error {
+	err := s.repository.Update(t)
+	if errors.Is(err, task.ErrVersionConflict) {
+		s.logger.Warn("task changed concurrently", "task_id", t.ID)
+		return apperror.PreconditionFailed("task_version_conflict").Wrap(err)
+	}
+	return err
+}
+
+// completedAt returns the completion time of a task entering the status, nil unless the status is done
+func completedAt(status entity.Status) *time.Time {
+	if status != entity.StatusDone {
+		return nil
+	}
+	now := time.Now().UTC()
+	return &now
+}
+
+// ********************* Helper: Record History *********************
+
+// recordHistory stores task changes, a failure is logged but doesn't fail the change itself
+func (s *Service) recordHistory(entries ...entity.History) {
+	if len(entries) == 0 {
+		return
+	}
+
+	if err := s.repository.CreateHistory(entries); err != nil {
+		s.logger.Error("error recording task history", "error", err)
+	}
+}
+
 // ********************* Helper: Update Task Metrics *********************
 func (s *Service) updateTaskMetrics() {
 	counts, err := s.repository.CountByStatus()
@@ -2,12 +2,15 @@ package task_test
 
 import (
 	"fmt"
+	projectR "task_mng/domain/project"
 	taskR "task_mng/domain/task"
 	"task_mng/domain/task/entity"
 	userR "task_mng/domain/user"
 	userEntity "task_mng/domain/user/entity"
+	"task_mng/pkg/notifier"
 	"task_mng/pkg/postgres"
 	"task_mng/pkg/redis"
+	"task_mng/pkg/storage"
 	"task_mng/services/task"
 	"testing"
 	"time"
@@ -82,7 +85,12 @@ func setupTestService(t *testing.T) (*task.Service, *postgres.Database, func())
 	taskRepo := taskR.New(db)
 	userRepo := userR.New(db)
 
-	service := task.New(taskRepo, redis, userRepo)
+	store, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create storage: %v", err)
+	}
+
+	service := task.New(taskRepo, redis, userRepo, notifier.NewLogNotifier(), projectR.New(db), store)
 
 	cleanup := func() {
 		dbCleanup()
@@ -106,7 +114,7 @@ func TestTaskIntegration_Success_CreateTask(t *testing.T) {
 		DueDate:     &dueDate,
 	}
 
-	err := service.Create(req)
+	err := service.Create(req, 0)
 
 	assert.NoError(t, err)
 }
@@ -126,7 +134,7 @@ func TestTaskIntegration_CreateTask_WithNonexistentAssignee(t *testing.T) {
 		DueDate:     &dueDate,
 	}
 
-	err := service.Create(req)
+	err := service.Create(req, 0)
 
 	assert.Error(t, err)
 	assert.Equal(t, "can't find assignee user", err.Error())
@@ -146,7 +154,7 @@ func TestTaskIntegration_UpdateTask(t *testing.T) {
 		DueDate:     &dueDate,
 	}
 
-	err := service.Create(createReq)
+	err := service.Create(createReq, 0)
 	assert.NoError(t, err)
 
 	var createdTask entity.Task
@@ -161,7 +169,7 @@ func TestTaskIntegration_UpdateTask(t *testing.T) {
 		DueDate:     time.Now().Add(time.Hour * 48),
 	}
 
-	err = service.Update(updateReq, fmt.Sprintf("%d", createdTask.ID))
+	err = service.Update(updateReq, fmt.Sprintf("%d", createdTask.ID), 0)
 	assert.NoError(t, err)
 
 	var updatedTask entity.Task
@@ -186,7 +194,7 @@ func TestTaskIntegration_FindAll(t *testing.T) {
 			DueDate:     &dueDate,
 		}
 
-		err := service.Create(req)
+		err := service.Create(req, 0)
 		assert.NoError(t, err)
 	}
 
@@ -212,7 +220,7 @@ func TestTaskIntegration_StatusTransition(t *testing.T) {
 		DueDate:     &dueDate,
 	}
 
-	err := service.Create(createReq)
+	err := service.Create(createReq, 0)
 	assert.NoError(t, err)
 
 	var createdTask entity.Task
@@ -224,7 +232,7 @@ func TestTaskIntegration_StatusTransition(t *testing.T) {
 		Status: entity.StatusInProgress,
 	}
 
-	err = service.StatusTransition(statusReq)
+	err = service.StatusTransition(statusReq, 0)
 	assert.NoError(t, err)
 
 	var updatedTask entity.Task
@@ -247,7 +255,7 @@ func TestTaskIntegration_DeleteTask(t *testing.T) {
 		DueDate:     &dueDate,
 	}
 
-	err := service.Create(createReq)
+	err := service.Create(createReq, 0)
 	assert.NoError(t, err)
 
 	var createdTask entity.Task
@@ -279,7 +287,7 @@ func TestTaskIntegration_AssignTask(t *testing.T) {
 		DueDate:     &dueDate,
 	}
 
-	err := service.Create(createReq)
+	err := service.Create(createReq, 0)
 	assert.NoError(t, err)
 
 	var createdTask entity.Task
@@ -295,7 +303,7 @@ func TestTaskIntegration_AssignTask(t *testing.T) {
 		Assignee: "bob",
 	}
 
-	err = service.Assign(assignReq)
+	err = service.Assign(assignReq, 0)
 	assert.NoError(t, err)
 
 	var reassignedTask entity.Task
@@ -318,7 +326,7 @@ func TestTaskIntegration_FindByID(t *testing.T) {
 		DueDate:     &dueDate,
 	}
 
-	err := service.Create(createReq)
+	err := service.Create(createReq, 0)
 	assert.NoError(t, err)
 
 	var createdTask entity.Task
@@ -370,7 +378,7 @@ func TestTaskIntegration_FindAll_WithFilters(t *testing.T) {
 			Priority:    &priority,
 			DueDate:     &dueDate,
 		}
-		err := service.Create(req)
+		err := service.Create(req, 0)
 		require.NoError(t, err)
 
 		if tc.Status != entity.StatusTodo {
@@ -382,7 +390,7 @@ func TestTaskIntegration_FindAll_WithFilters(t *testing.T) {
 				TaskID: createdTask.ID,
 				Status: tc.Status,
 			}
-			err = service.StatusTransition(statusReq)
+			err = service.StatusTransition(statusReq, 0)
 			require.NoError(t, err)
 		}
 	}
@@ -420,7 +428,7 @@ func TestTaskIntegration_Pagination(t *testing.T) {
 			Priority:    &priority,
 			DueDate:     &dueDate,
 		}
-		err := service.Create(req)
+		err := service.Create(req, 0)
 		require.NoError(t, err)
 	}
 
@@ -453,7 +461,7 @@ func TestTaskIntegration_CacheBehavior(t *testing.T) {
 		Priority:    &priority,
 		DueDate:     &dueDate,
 	}
-	err := service.Create(createReq)
+	err := service.Create(createReq, 0)
 	require.NoError(t, err)
 
 	filter := &task.FilterRequest{}
@@ -462,7 +470,7 @@ func TestTaskIntegration_CacheBehavior(t *testing.T) {
 	initialCount := len(result1.Tasks)
 
 	createReq.Summary = "Cache Test Task 2"
-	err = service.Create(createReq)
+	err = service.Create(createReq, 0)
 	require.NoError(t, err)
 
 	result2, err := service.FindAll(filter, 1, 10)
@@ -495,7 +503,7 @@ func TestTaskIntegration_MultipleStatusTransitions(t *testing.T) {
 		DueDate:     &dueDate,
 	}
 
-	err := service.Create(createReq)
+	err := service.Create(createReq, 0)
 	assert.NoError(t, err)
 
 	var createdTask entity.Task
@@ -507,7 +515,7 @@ func TestTaskIntegration_MultipleStatusTransitions(t *testing.T) {
 		TaskID: createdTask.ID,
 		Status: entity.StatusInProgress,
 	}
-	err = service.StatusTransition(statusReq)
+	err = service.StatusTransition(statusReq, 0)
 	assert.NoError(t, err)
 
 	err = db.GetDB().First(&createdTask, createdTask.ID).Error
@@ -515,7 +523,7 @@ func TestTaskIntegration_MultipleStatusTransitions(t *testing.T) {
 	assert.Equal(t, entity.StatusInProgress, createdTask.Status)
 
 	statusReq.Status = entity.StatusDone
-	err = service.StatusTransition(statusReq)
+	err = service.StatusTransition(statusReq, 0)
 	assert.NoError(t, err)
 
 	err = db.GetDB().First(&createdTask, createdTask.ID).Error
@@ -535,7 +543,7 @@ func TestTaskIntegration_UpdateNonexistentTask(t *testing.T) {
 		DueDate:     time.Now().Add(time.Hour * 48),
 	}
 
-	err := service.Update(updateReq, "99999")
+	err := service.Update(updateReq, "99999", 0)
 	assert.Error(t, err)
 	assert.Equal(t, "task_not_found", err.Error())
 }
@@ -554,7 +562,7 @@ func TestTaskIntegration_AssignToNonexistentUser(t *testing.T) {
 		DueDate:     &dueDate,
 	}
 
-	err := service.Create(createReq)
+	err := service.Create(createReq, 0)
 	assert.NoError(t, err)
 
 	var createdTask entity.Task
@@ -566,7 +574,47 @@ func TestTaskIntegration_AssignToNonexistentUser(t *testing.T) {
 		Assignee: "nonexistent_user",
 	}
 
-	err = service.Assign(assignReq)
+	err = service.Assign(assignReq, 0)
 	assert.Error(t, err)
 	assert.Equal(t, "can't find assignee user", err.Error())
 }
+
+func TestTaskIntegration_StaleUpdateAfterRepositoryWrites(t *testing.T) {
+	_, db, cleanup := setupTestService(t)
+	defer cleanup()
+
+	repo := taskR.New(db)
+
+	writes := map[string]func(entity.Task) error{
+		"Rebalance": func(e entity.Task) error {
+			moved := entity.Task{Summary: "Moved", Status: entity.StatusTodo, Priority: entity.PriorityLow, DueDate: time.Now()}
+			if err := db.GetDB().Create(&moved).Error; err != nil {
+				return err
+			}
+			return repo.Rebalance(moved.ID, map[string]interface{}{"rank": "m"}, map[uint]string{e.ID: "n"})
+		},
+		"Restore": func(e entity.Task) error {
+			if err := db.GetDB().Delete(&e).Error; err != nil {
+				return err
+			}
+			return repo.Restore(e.ID, nil)
+		},
+		"ArchiveDone": func(e entity.Task) error {
+			_, err := repo.ArchiveDone(time.Now().Add(time.Hour))
+			return err
+		},
+	}
+
+	for name, write := range writes {
+		t.Run(name, func(t *testing.T) {
+			stale := entity.Task{Summary: "Stale " + name, Status: entity.StatusDone, Priority: entity.PriorityLow, DueDate: time.Now().Add(time.Hour * 24)}
+			require.NoError(t, db.GetDB().Create(&stale).Error)
+
+			require.NoError(t, write(stale))
+
+			stale.Summary = "Overwritten"
+			err := repo.Update(stale)
+			assert.ErrorIs(t, err, taskR.ErrVersionConflict)
+		})
+	}
+}
@@ -2,13 +2,19 @@ package task
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	projectMocks "task_mng/domain/project/mocks"
 	"task_mng/domain/task"
 	"task_mng/domain/task/entity"
 	"task_mng/domain/task/mocks"
 	userEntity "task_mng/domain/user/entity"
 	userMocks "task_mng/domain/user/mocks"
+	"task_mng/pkg/apperror"
+	notifierMocks "task_mng/pkg/notifier/mocks"
 	redisMocks "task_mng/pkg/redis/mocks"
+	storageMocks "task_mng/pkg/storage/mocks"
 	"testing"
 	"time"
 
@@ -25,7 +31,7 @@ func TestCreateTask_Success(t *testing.T) {
 	// Mock CountByStatus for metrics initialization in New() and after Create()
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil).Twice()
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	priority := entity.PriorityMedium
 	dueDate := time.Now().Add(time.Hour * 24)
@@ -49,11 +55,16 @@ func TestCreateTask_Success(t *testing.T) {
 			t.Description == req.Description &&
 			t.Priority == *req.Priority &&
 			t.Assignee == 1 &&
+			t.Reporter != nil && *t.Reporter == 1 &&
+			t.CreatedBy != nil && *t.CreatedBy == 1 &&
 			t.Status == entity.StatusTodo &&
 			t.DueDate.Equal(*req.DueDate)
 	})).Return(nil)
 
-	err := service.Create(req)
+	// The creator is also the assignee, so it watches the task once
+	mockRepo.On("AddWatchers", uint(0), []uint{1}).Return(nil)
+
+	err := service.Create(req, 1)
 
 	assert.NoError(t, err)
 	mockRepo.AssertExpectations(t)
@@ -68,7 +79,7 @@ func TestCreateTask_UserNotFound(t *testing.T) {
 	// Mock CountByStatus for metrics initialization in New()
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	priority := entity.PriorityMedium
 	dueDate := time.Now().Add(time.Hour * 24)
@@ -84,7 +95,7 @@ func TestCreateTask_UserNotFound(t *testing.T) {
 	// Mock user repository to return user not found error
 	mockUserRepo.On("FindByUsername", req.Assignee).Return(userEntity.User{}, gorm.ErrRecordNotFound)
 
-	err := service.Create(req)
+	err := service.Create(req, 1)
 
 	assert.Error(t, err)
 	assert.Equal(t, err.Error(), "can't find assignee user")
@@ -103,7 +114,7 @@ func TestUpdateTask_Success(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	taskID := uint(1)
 	priority := entity.PriorityMedium
@@ -142,13 +153,67 @@ func TestUpdateTask_Success(t *testing.T) {
 			t.DueDate.Equal(dueDate)
 	})).Return(nil)
 
-	err := service.Update(req, "1")
+	mockRepo.On("FindWatchers", taskID).Return([]entity.Watcher{}, nil)
+
+	err := service.Update(req, "1", 1)
 
 	assert.NoError(t, err)
 	mockRepo.AssertExpectations(t)
 	mockUserRepo.AssertExpectations(t)
 }
 
+func TestUpdateTask_StaleVersion(t *testing.T) {
+	mockRepo := new(mocks.MockTaskRepository)
+	mockUserRepo := new(userMocks.MockUserRepository)
+
+	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
+
+	service := New(mockRepo, &redisMocks.MockRedisClient{}, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
+
+	version := uint(2)
+	req := &UpdateRequest{
+		Summary:  "Test Task",
+		Assignee: "test.user",
+		Version:  &version,
+	}
+
+	mockRepo.On("FindByID", uint(1)).Return(entity.Task{Model: gorm.Model{ID: 1}, Version: 3}, nil)
+
+	err := service.Update(req, "1", 1)
+
+	assert.Error(t, err)
+	assert.Equal(t, "task_version_conflict", err.Error())
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything)
+	mockUserRepo.AssertNotCalled(t, "FindByUsername", mock.Anything)
+}
+
+func TestUpdateTask_ConcurrentChange(t *testing.T) {
+	mockRepo := new(mocks.MockTaskRepository)
+	mockUserRepo := new(userMocks.MockUserRepository)
+
+	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
+
+	service := New(mockRepo, &redisMocks.MockRedisClient{}, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
+
+	req := &UpdateRequest{
+		Summary:  "Test Task",
+		Assignee: "test.user",
+	}
+
+	mockUserRepo.On("FindByUsername", req.Assignee).Return(userEntity.User{Model: gorm.Model{ID: 1}, Username: req.Assignee}, nil)
+	mockRepo.On("FindByID", uint(1)).Return(entity.Task{Model: gorm.Model{ID: 1}, Assignee: 1, Version: 3}, nil)
+	mockRepo.On("Update", mock.Anything).Return(task.ErrVersionConflict)
+
+	err := service.Update(req, "1", 1)
+
+	// A concurrent write answers the same as a stale If-Match
+	var appErr *apperror.Error
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, http.StatusPreconditionFailed, appErr.Status)
+	assert.Equal(t, "task_version_conflict", err.Error())
+	mockRepo.AssertNotCalled(t, "FindWatchers", mock.Anything)
+}
+
 func TestUpdateTask_UserNotFound(t *testing.T) {
 	mockRepo := new(mocks.MockTaskRepository)
 	redisMock := &redisMocks.MockRedisClient{
@@ -160,7 +225,7 @@ func TestUpdateTask_UserNotFound(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	taskID := uint(1)
 	priority := entity.PriorityMedium
@@ -187,7 +252,7 @@ func TestUpdateTask_UserNotFound(t *testing.T) {
 	// Mock user repository to return error (user not found)
 	mockUserRepo.On("FindByUsername", req.Assignee).Return(userEntity.User{}, fmt.Errorf("record not found"))
 
-	err := service.Update(req, "1")
+	err := service.Update(req, "1", 1)
 
 	assert.Error(t, err)
 	assert.Equal(t, err.Error(), "can't find assignee user")
@@ -206,7 +271,7 @@ func TestUpdateTask_TaskNotFound(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	taskID := uint(1)
 	priority := entity.PriorityMedium
@@ -223,7 +288,7 @@ func TestUpdateTask_TaskNotFound(t *testing.T) {
 	// Mock FindByID to return error (task not found)
 	mockRepo.On("FindByID", taskID).Return(entity.Task{}, gorm.ErrRecordNotFound)
 
-	err := service.Update(req, "1")
+	err := service.Update(req, "1", 1)
 
 	assert.Error(t, err)
 	assert.Equal(t, err.Error(), "task_not_found")
@@ -238,10 +303,11 @@ func TestFindByID_Success(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	taskID := uint(1)
 	assigneeID := uint(1)
+	reporterID := uint(2)
 
 	dueDate := time.Now().Add(time.Hour * 24)
 	mockRepo.On("FindByID", taskID).Return(entity.Task{
@@ -250,14 +316,16 @@ func TestFindByID_Success(t *testing.T) {
 		Description: "Description",
 		Priority:    entity.PriorityMedium,
 		Assignee:    assigneeID,
+		Reporter:    &reporterID,
+		CreatedBy:   &reporterID,
 		Status:      entity.StatusTodo,
 		DueDate:     dueDate,
 	}, nil)
 
-	// Mock user repository to return assignee user
-	mockUserRepo.On("FindByID", assigneeID).Return(userEntity.User{
-		Model:    gorm.Model{ID: assigneeID},
-		Username: "test.user",
+	// Mock user repository to return the assignee and reporter users
+	mockUserRepo.On("FindByIDs", []uint{assigneeID, reporterID}).Return([]userEntity.User{
+		{Model: gorm.Model{ID: assigneeID}, Username: "test.user"},
+		{Model: gorm.Model{ID: reporterID}, Username: "reporter"},
 	}, nil)
 
 	task, err := service.FindByID("1")
@@ -269,6 +337,9 @@ func TestFindByID_Success(t *testing.T) {
 	assert.Equal(t, entity.PriorityMedium, task.Priority)
 	assert.Equal(t, uint(1), task.Assignee.ID)
 	assert.Equal(t, "test.user", task.Assignee.Username)
+	assert.Equal(t, "reporter", task.Reporter.Username)
+	assert.Equal(t, reporterID, task.CreatedBy.ID)
+	assert.Nil(t, task.UpdatedBy)
 	assert.Equal(t, entity.StatusTodo, task.Status)
 	assert.Equal(t, dueDate, task.DueDate)
 	mockRepo.AssertExpectations(t)
@@ -282,7 +353,7 @@ func TestFindByID_TaskNotFound(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	taskID := uint(1)
 
@@ -313,7 +384,7 @@ func TestFindAll_Success(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	dueDate := time.Now().Add(time.Hour * 24)
 	mockRepo.On("FindAll", mock.MatchedBy(func(filter *task.Filter) bool {
@@ -367,7 +438,7 @@ func TestFindAll_EmptyResult(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	mockRepo.On("FindAll", mock.MatchedBy(func(filter *task.Filter) bool {
 		return filter.Assignee == nil && filter.Status == nil && filter.Priority == nil
@@ -384,6 +455,65 @@ func TestFindAll_EmptyResult(t *testing.T) {
 	mockUserRepo.AssertExpectations(t)
 }
 
+func TestFindAll_FilterByReporter(t *testing.T) {
+	mockRepo := new(mocks.MockTaskRepository)
+	redisMock := &redisMocks.MockRedisClient{
+		GetFunc: func(ctx context.Context, key string) (string, error) {
+			if key == "tasks:cache:version" {
+				return "1", nil
+			}
+			return "", fmt.Errorf("cache miss")
+		},
+	}
+	mockUserRepo := new(userMocks.MockUserRepository)
+
+	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
+
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
+
+	reporter := "reporter"
+	mockUserRepo.On("FindByUsername", reporter).Return(userEntity.User{
+		Model:    gorm.Model{ID: 2},
+		Username: reporter,
+	}, nil)
+
+	mockRepo.On("FindAll", mock.MatchedBy(func(filter *task.Filter) bool {
+		return filter.Reporter != nil && *filter.Reporter == 2
+	}), 1, 10).Return([]entity.Task{}, int64(0), nil)
+
+	taskList, err := service.FindAll(&FilterRequest{Reporter: &reporter}, 1, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(taskList.Tasks))
+	mockRepo.AssertExpectations(t)
+	mockUserRepo.AssertExpectations(t)
+}
+
+func TestFindAll_UnknownReporter(t *testing.T) {
+	mockRepo := new(mocks.MockTaskRepository)
+	redisMock := &redisMocks.MockRedisClient{
+		GetFunc: func(ctx context.Context, key string) (string, error) {
+			if key == "tasks:cache:version" {
+				return "1", nil
+			}
+			return "", fmt.Errorf("cache miss")
+		},
+	}
+	mockUserRepo := new(userMocks.MockUserRepository)
+
+	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
+
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
+
+	reporter := "nobody"
+	mockUserRepo.On("FindByUsername", reporter).Return(userEntity.User{}, gorm.ErrRecordNotFound)
+
+	_, err := service.FindAll(&FilterRequest{Reporter: &reporter}, 1, 10)
+
+	assert.Equal(t, "reporter_not_found", err.Error())
+	mockRepo.AssertNotCalled(t, "FindAll", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestDeleteTask_Success(t *testing.T) {
 	mockRepo := new(mocks.MockTaskRepository)
 	redisMock := &redisMocks.MockRedisClient{
@@ -395,7 +525,7 @@ func TestDeleteTask_Success(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	taskID := uint(1)
 	dueDate := time.Now().Add(time.Hour * 24)
@@ -433,7 +563,7 @@ func TestDeleteTask_TaskNotFound(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	taskID := uint(1)
 
@@ -458,7 +588,7 @@ func TestAssignTask_Success(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	taskID := uint(1)
 	assigneeID := uint(2)
@@ -482,10 +612,13 @@ func TestAssignTask_Success(t *testing.T) {
 		return t.ID == taskID && t.Assignee == assigneeID
 	})).Return(nil)
 
+	mockRepo.On("AddWatchers", taskID, []uint{assigneeID}).Return(nil)
+	mockRepo.On("FindWatchers", taskID).Return([]entity.Watcher{{TaskID: taskID, UserID: 1}, {TaskID: taskID, UserID: assigneeID}}, nil)
+
 	err := service.Assign(&AssignRequest{
 		TaskID:   taskID,
 		Assignee: "assignee",
-	})
+	}, 1)
 
 	assert.NoError(t, err)
 	mockRepo.AssertExpectations(t)
@@ -504,7 +637,7 @@ func TestAssignTask_TaskNotFound(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	taskID := uint(1)
 	assigneeID := uint(2)
@@ -521,7 +654,7 @@ func TestAssignTask_TaskNotFound(t *testing.T) {
 	err := service.Assign(&AssignRequest{
 		TaskID:   taskID,
 		Assignee: "assignee",
-	})
+	}, 1)
 
 	assert.Error(t, err)
 	assert.Equal(t, err.Error(), "task_not_found")
@@ -540,7 +673,7 @@ func TestAssignTask_UserNotFound(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	taskID := uint(1)
 	assignee := "nonexistent.user"
@@ -551,7 +684,7 @@ func TestAssignTask_UserNotFound(t *testing.T) {
 	err := service.Assign(&AssignRequest{
 		TaskID:   taskID,
 		Assignee: assignee,
-	})
+	}, 1)
 
 	assert.Error(t, err)
 	assert.Equal(t, err.Error(), "can't find assignee user")
@@ -570,7 +703,7 @@ func TestStatusTransition_Success(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	taskID := uint(1)
 
@@ -584,14 +717,27 @@ func TestStatusTransition_Success(t *testing.T) {
 		DueDate:     time.Now().Add(time.Hour * 24),
 	}, nil)
 
+	mockRepo.On("FindColumn", entity.StatusInProgress).Return(entity.Column{}, gorm.ErrRecordNotFound)
+	mockRepo.On("LastRank", entity.StatusInProgress).Return("", nil)
+
 	mockRepo.On("Update", mock.MatchedBy(func(t entity.Task) bool {
-		return t.ID == taskID && t.Status == entity.StatusInProgress
+		return t.ID == taskID && t.Status == entity.StatusInProgress && t.Rank != ""
 	})).Return(nil)
 
+	mockRepo.On("CreateHistory", []entity.History{{
+		TaskID:   taskID,
+		Field:    entity.HistoryFieldStatus,
+		OldValue: string(entity.StatusTodo),
+		NewValue: string(entity.StatusInProgress),
+		UserID:   userRef(1),
+	}}).Return(nil)
+
+	mockRepo.On("FindWatchers", taskID).Return([]entity.Watcher{}, nil)
+
 	err := service.StatusTransition(&StatusTransitionRequest{
 		TaskID: taskID,
 		Status: entity.StatusInProgress,
-	})
+	}, 1)
 
 	assert.NoError(t, err)
 	mockRepo.AssertExpectations(t)
@@ -609,7 +755,7 @@ func TestStatusTransition_TaskNotFound(t *testing.T) {
 
 	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
 
-	service := New(mockRepo, redisMock, mockUserRepo)
+	service := New(mockRepo, redisMock, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
 
 	taskID := uint(1)
 
@@ -618,7 +764,7 @@ func TestStatusTransition_TaskNotFound(t *testing.T) {
 	err := service.StatusTransition(&StatusTransitionRequest{
 		TaskID: taskID,
 		Status: entity.StatusInProgress,
-	})
+	}, 1)
 
 	assert.Error(t, err)
 	assert.Equal(t, err.Error(), "task_not_found")
@@ -0,0 +1,141 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"task_mng/domain/task/aggregate"
+	"task_mng/domain/task/entity"
+	"task_mng/pkg/apperror"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// TrashConfig controls how long deleted tasks are kept before they are purged
+type TrashConfig struct {
+	// Retention is how long a deleted task stays in the trash
+	Retention time.Duration
+}
+
+// ********************* Trash *********************
+
+// Trash returns the soft deleted tasks, most recently deleted first
+func (s *Service) Trash(page, limit int) (*aggregate.TaskListResponse, error) {
+	tasks, count, err := s.repository.FindTrash(page, limit)
+	if err != nil {
+		s.logger.Error("error finding deleted tasks", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewTaskListResponse(tasks, s.usernames(tasks), page, limit, count, ""), nil
+}
+
+// ********************* Restore *********************
+
+// Restore brings a task back from the trash, its board column has to have room for it
+func (s *Service) Restore(id string, userID uint) error {
+	t, err := s.findTaskUnscoped(id)
+	if err != nil {
+		return err
+	}
+
+	if !t.DeletedAt.Valid {
+		return apperror.Conflict("task_not_in_trash")
+	}
+
+	if err := s.checkWipLimit(t.Status); err != nil {
+		return err
+	}
+
+	err = s.repository.Restore(t.ID, userRef(userID))
+	if err != nil {
+		s.logger.Error("error restoring task", "error", err)
+		return apperror.Internal(err)
+	}
+
+	// Invalidate cache after restoring a task
+	s.invalidateTasksCache()
+
+	// Update task count metrics
+	s.updateTaskMetrics()
+
+	return nil
+}
+
+// ********************* Purge *********************
+
+// Purge deletes a task for good, whether it is in the trash or not
+func (s *Service) Purge(id string) error {
+	t, err := s.findTaskUnscoped(id)
+	if err != nil {
+		return err
+	}
+
+	keys, err := s.repository.HardDelete(t.ID)
+	if err != nil {
+		s.logger.Error("error purging task", "error", err)
+		return apperror.Internal(err)
+	}
+
+	s.deleteAttachmentFiles(context.Background(), keys)
+
+	if !t.DeletedAt.Valid {
+		// Invalidate cache after removing a live task
+		s.invalidateTasksCache()
+
+		// Update task count metrics
+		s.updateTaskMetrics()
+	}
+
+	return nil
+}
+
+// PurgeTrash deletes the tasks that have been in the trash for longer than the retention for good
+func (s *Service) PurgeTrash(ctx context.Context, cfg TrashConfig) error {
+	purged, keys, err := s.repository.PurgeDeleted(time.Now().UTC().Add(-cfg.Retention))
+	if err != nil {
+		s.logger.Error("error purging trash", "error", err)
+		return err
+	}
+
+	s.deleteAttachmentFiles(ctx, keys)
+
+	if purged > 0 {
+		s.logger.Info("Purged tasks from the trash", "count", purged, "retention", cfg.Retention)
+	}
+
+	return nil
+}
+
+// Helper functions
+
+// deleteAttachmentFiles removes the files of purged attachments, it runs after the rows are gone
+// so a failure only leaves an orphaned file behind
+func (s *Service) deleteAttachmentFiles(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := s.storage.Delete(ctx, key); err != nil {
+			s.logger.Warn("error deleting attachment blob", "key", key, "error", err)
+		}
+	}
+}
+
+func (s *Service) findTaskUnscoped(id string) (entity.Task, error) {
+	uintID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		s.logger.Error("error parsing id", "error", err)
+		return entity.Task{}, apperror.BadRequest("invalid_id")
+	}
+
+	t, err := s.repository.FindByIDUnscoped(uint(uintID))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding task", "error", err)
+			return entity.Task{}, apperror.Internal(err)
+		}
+		s.logger.Error("task not found", "error", err)
+		return entity.Task{}, apperror.NotFound("task_not_found")
+	}
+
+	return t, nil
+}
@@ -0,0 +1,117 @@
+package task
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	projectMocks "task_mng/domain/project/mocks"
+	"task_mng/domain/task/entity"
+	"task_mng/domain/task/mocks"
+	userMocks "task_mng/domain/user/mocks"
+	notifierMocks "task_mng/pkg/notifier/mocks"
+	redisMocks "task_mng/pkg/redis/mocks"
+	storageMocks "task_mng/pkg/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func deletedTask(id uint, status entity.Status) entity.Task {
+	return entity.Task{
+		Model: gorm.Model{
+			ID:        id,
+			DeletedAt: gorm.DeletedAt{Time: time.Now(), Valid: true},
+		},
+		Status: status,
+	}
+}
+
+func TestRestore_Success(t *testing.T) {
+	service, mockRepo := newBoardTestService()
+
+	mockRepo.On("FindByIDUnscoped", uint(1)).Return(deletedTask(1, entity.StatusTodo), nil)
+	mockRepo.On("FindColumn", entity.StatusTodo).Return(entity.Column{}, gorm.ErrRecordNotFound)
+	mockRepo.On("Restore", uint(1), userRef(5)).Return(nil)
+
+	err := service.Restore("1", 5)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRestore_NotInTrash(t *testing.T) {
+	service, mockRepo := newBoardTestService()
+
+	mockRepo.On("FindByIDUnscoped", uint(1)).Return(entity.Task{Model: gorm.Model{ID: 1}}, nil)
+
+	err := service.Restore("1", 5)
+
+	assert.Error(t, err)
+	assert.Equal(t, "task_not_in_trash", err.Error())
+	mockRepo.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything)
+}
+
+func TestRestore_WipLimitReached(t *testing.T) {
+	mockRepo := new(mocks.MockTaskRepository)
+	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{entity.StatusInProgress: 2}, nil)
+	service := New(mockRepo, &redisMocks.MockRedisClient{}, new(userMocks.MockUserRepository), &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
+
+	mockRepo.On("FindByIDUnscoped", uint(1)).Return(deletedTask(1, entity.StatusInProgress), nil)
+	mockRepo.On("FindColumn", entity.StatusInProgress).Return(entity.Column{Status: entity.StatusInProgress, WipLimit: 2}, nil)
+
+	err := service.Restore("1", 5)
+
+	assert.Error(t, err)
+	assert.Equal(t, "wip_limit_reached", err.Error())
+	mockRepo.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything)
+}
+
+func TestPurge_TaskNotFound(t *testing.T) {
+	service, mockRepo := newBoardTestService()
+
+	mockRepo.On("FindByIDUnscoped", uint(1)).Return(entity.Task{}, gorm.ErrRecordNotFound)
+
+	err := service.Purge("1")
+
+	assert.Error(t, err)
+	assert.Equal(t, "task_not_found", err.Error())
+	mockRepo.AssertNotCalled(t, "HardDelete", mock.Anything)
+}
+
+func TestPurgeTrash_UsesRetention(t *testing.T) {
+	service, mockRepo := newBoardTestService()
+
+	retention := 30 * 24 * time.Hour
+	mockRepo.On("PurgeDeleted", mock.MatchedBy(func(before time.Time) bool {
+		return time.Since(before) >= retention && time.Since(before) < retention+time.Minute
+	})).Return(int64(3), []string{}, nil)
+
+	err := service.PurgeTrash(context.Background(), TrashConfig{Retention: retention})
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPurge_DeletesAttachmentFiles(t *testing.T) {
+	mockRepo := new(mocks.MockTaskRepository)
+	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil).Maybe()
+	var deleted []string
+	store := &storageMocks.MockStorage{
+		DeleteFunc: func(ctx context.Context, key string) error {
+			deleted = append(deleted, key)
+			return nil
+		},
+	}
+	service := New(mockRepo, &redisMocks.MockRedisClient{}, new(userMocks.MockUserRepository), &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), store)
+
+	mockRepo.On("FindByIDUnscoped", uint(1)).Return(deletedTask(1, entity.StatusTodo), nil)
+	mockRepo.On("HardDelete", uint(1)).Return([]string{"tasks/1/a.png", "tasks/1/b.pdf"}, nil)
+
+	err := service.Purge("1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tasks/1/a.png", "tasks/1/b.pdf"}, deleted)
+	mockRepo.AssertExpectations(t)
+}
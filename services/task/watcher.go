@@ -0,0 +1,153 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"task_mng/domain/task/aggregate"
+	"task_mng/domain/task/entity"
+	"task_mng/pkg/apperror"
+	"task_mng/pkg/notifier"
+
+	"gorm.io/gorm"
+)
+
+// ********************* Watchers *********************
+
+// Watch makes the user follow the updates of a task
+func (s *Service) Watch(id string, userID uint) error {
+	t, err := s.findTask(id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repository.AddWatchers(t.ID, []uint{userID}); err != nil {
+		s.logger.Error("error adding watcher", "task_id", t.ID, "error", err)
+		return apperror.Internal(err)
+	}
+
+	return nil
+}
+
+// Unwatch stops the user from following the updates of a task
+func (s *Service) Unwatch(id string, userID uint) error {
+	t, err := s.findTask(id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repository.RemoveWatcher(t.ID, userID); err != nil {
+		s.logger.Error("error removing watcher", "task_id", t.ID, "error", err)
+		return apperror.Internal(err)
+	}
+
+	return nil
+}
+
+func (s *Service) Watchers(id string) ([]aggregate.WatcherResponse, error) {
+	t, err := s.findTask(id)
+	if err != nil {
+		return nil, err
+	}
+
+	watchers, err := s.repository.FindWatchers(t.ID)
+	if err != nil {
+		s.logger.Error("error finding watchers", "task_id", t.ID, "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	userIDs := make([]uint, len(watchers))
+	for i, w := range watchers {
+		userIDs[i] = w.UserID
+	}
+
+	usernames := make(map[uint]string)
+	if len(userIDs) > 0 {
+		users, err := s.userRepository.FindByIDs(userIDs)
+		if err != nil {
+			s.logger.Warn("error finding watcher users", "error", err)
+		} else {
+			for _, user := range users {
+				usernames[user.ID] = user.Username
+			}
+		}
+	}
+
+	return aggregate.NewWatcherListResponse(watchers, usernames), nil
+}
+
+// Helper functions
+
+func (s *Service) findTask(id string) (entity.Task, error) {
+	uintID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		s.logger.Error("error parsing id", "error", err)
+		return entity.Task{}, apperror.BadRequest("invalid_id")
+	}
+
+	t, err := s.repository.FindByID(uint(uintID))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding task", "error", err)
+			return entity.Task{}, apperror.Internal(err)
+		}
+		s.logger.Error("task not found", "error", err)
+		return entity.Task{}, apperror.NotFound("task_not_found")
+	}
+
+	return t, nil
+}
+
+// watch makes users watch a task, a failure is logged but doesn't fail the change itself
+func (s *Service) watch(taskID uint, userIDs ...uint) {
+	ids := make([]uint, 0, len(userIDs))
+	seen := make(map[uint]bool)
+	for _, id := range userIDs {
+		if id != 0 && !seen[id] {
+			ids = append(ids, id)
+			seen[id] = true
+		}
+	}
+
+	if err := s.repository.AddWatchers(taskID, ids); err != nil {
+		s.logger.Error("error adding watchers", "task_id", taskID, "error", err)
+	}
+}
+
+// audience returns the users that get notified about a task, its watchers and always its assignee
+func (s *Service) audience(t entity.Task) []uint {
+	userIDs := []uint{t.Assignee}
+
+	watchers, err := s.repository.FindWatchers(t.ID)
+	if err != nil {
+		s.logger.Error("error finding watchers", "task_id", t.ID, "error", err)
+		return userIDs
+	}
+
+	for _, w := range watchers {
+		if w.UserID != t.Assignee {
+			userIDs = append(userIDs, w.UserID)
+		}
+	}
+	return userIDs
+}
+
+// notifyWatchers tells the audience of a task about a change, the user who made the change is skipped
+func (s *Service) notifyWatchers(t entity.Task, actorID uint, subject, message string) {
+	ctx := context.Background()
+
+	for _, userID := range s.audience(t) {
+		if userID == actorID {
+			continue
+		}
+
+		err := s.notifier.Notify(ctx, notifier.Notification{
+			UserID:  userID,
+			Subject: subject,
+			Message: message,
+		})
+		if err != nil {
+			s.logger.Error("failed to notify watcher", "task_id", t.ID, "user_id", userID, "error", err)
+		}
+	}
+}
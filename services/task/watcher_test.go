@@ -0,0 +1,103 @@
+package task
+
+import (
+	"context"
+	"testing"
+
+	projectMocks "task_mng/domain/project/mocks"
+	"task_mng/domain/task/entity"
+	"task_mng/domain/task/mocks"
+	userEntity "task_mng/domain/user/entity"
+	userMocks "task_mng/domain/user/mocks"
+	"task_mng/pkg/notifier"
+	notifierMocks "task_mng/pkg/notifier/mocks"
+	redisMocks "task_mng/pkg/redis/mocks"
+	storageMocks "task_mng/pkg/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestWatch_Success(t *testing.T) {
+	service, mockRepo := newBoardTestService()
+
+	mockRepo.On("FindByID", uint(1)).Return(entity.Task{Model: gorm.Model{ID: 1}}, nil)
+	mockRepo.On("AddWatchers", uint(1), []uint{5}).Return(nil)
+	mockRepo.On("RemoveWatcher", uint(1), uint(5)).Return(nil)
+
+	assert.NoError(t, service.Watch("1", 5))
+	assert.NoError(t, service.Unwatch("1", 5))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestWatch_TaskNotFound(t *testing.T) {
+	service, mockRepo := newBoardTestService()
+
+	mockRepo.On("FindByID", uint(1)).Return(entity.Task{}, gorm.ErrRecordNotFound)
+
+	err := service.Watch("1", 5)
+
+	assert.Error(t, err)
+	assert.Equal(t, "task_not_found", err.Error())
+	mockRepo.AssertNotCalled(t, "AddWatchers")
+}
+
+func TestWatchers_Success(t *testing.T) {
+	mockRepo := new(mocks.MockTaskRepository)
+	mockUserRepo := new(userMocks.MockUserRepository)
+	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
+	service := New(mockRepo, &redisMocks.MockRedisClient{}, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
+
+	mockRepo.On("FindByID", uint(1)).Return(entity.Task{Model: gorm.Model{ID: 1}}, nil)
+	mockRepo.On("FindWatchers", uint(1)).Return([]entity.Watcher{{TaskID: 1, UserID: 5}}, nil)
+	mockUserRepo.On("FindByIDs", []uint{5}).Return([]userEntity.User{{Model: gorm.Model{ID: 5}, Username: "watcher"}}, nil)
+
+	result, err := service.Watchers("1")
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, "watcher", result[0].Username)
+}
+
+func TestStatusTransition_NotifiesWatchersExceptActor(t *testing.T) {
+	mockRepo := new(mocks.MockTaskRepository)
+	mockRepo.On("CountByStatus").Return(map[entity.Status]int64{}, nil)
+
+	var sent []notifier.Notification
+	notifierMock := &notifierMocks.MockNotifier{
+		NotifyFunc: func(ctx context.Context, notification notifier.Notification) error {
+			sent = append(sent, notification)
+			return nil
+		},
+	}
+	service := New(mockRepo, &redisMocks.MockRedisClient{}, new(userMocks.MockUserRepository), notifierMock, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
+
+	mockRepo.On("FindByID", uint(1)).Return(entity.Task{Model: gorm.Model{ID: 1}, Assignee: 2, Status: entity.StatusTodo}, nil)
+	mockRepo.On("FindColumn", entity.StatusDone).Return(entity.Column{}, gorm.ErrRecordNotFound)
+	mockRepo.On("LastRank", entity.StatusDone).Return("", nil)
+	mockRepo.On("Update", mock.MatchedBy(func(t entity.Task) bool {
+		return t.ID == 1 && t.Status == entity.StatusDone && t.Rank == "i" && *t.UpdatedBy == 3 && t.CompletedAt != nil
+	})).Return(nil)
+	mockRepo.On("CreateHistory", []entity.History{{
+		TaskID:   1,
+		Field:    entity.HistoryFieldStatus,
+		OldValue: string(entity.StatusTodo),
+		NewValue: string(entity.StatusDone),
+		UserID:   userRef(3),
+	}}).Return(nil)
+	mockRepo.On("FindWatchers", uint(1)).Return([]entity.Watcher{
+		{TaskID: 1, UserID: 2},
+		{TaskID: 1, UserID: 3},
+		{TaskID: 1, UserID: 4},
+	}, nil)
+
+	err := service.StatusTransition(&StatusTransitionRequest{TaskID: 1, Status: entity.StatusDone}, 3)
+
+	assert.NoError(t, err)
+	assert.Len(t, sent, 2)
+	assert.Equal(t, uint(2), sent[0].UserID)
+	assert.Equal(t, uint(4), sent[1].UserID)
+	assert.Equal(t, "Task status changed", sent[0].Subject)
+	mockRepo.AssertExpectations(t)
+}
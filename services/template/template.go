@@ -0,0 +1,221 @@
+package template
+
+import (
+	"errors"
+	"log/slog"
+	"strconv"
+	taskEntity "task_mng/domain/task/entity"
+	"task_mng/domain/template"
+	"task_mng/domain/template/aggregate"
+	"task_mng/domain/template/entity"
+	"task_mng/pkg/apperror"
+	"task_mng/services/checklist"
+	"task_mng/services/task"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"gorm.io/gorm"
+)
+
+type Service struct {
+	repository       template.Repository
+	logger           *slog.Logger
+	taskService      *task.Service
+	checklistService *checklist.Service
+}
+
+func New(repository template.Repository, taskService *task.Service, checklistService *checklist.Service) *Service {
+	return &Service{repository: repository, logger: slog.Default(), taskService: taskService, checklistService: checklistService}
+}
+
+// ********************* Create *********************
+type CreateRequest struct {
+	Name        string               `json:"name" valid:"required~name_is_required,length(3|64)~name_must_be_3_to_64_characters" example:"incident-postmortem"`
+	Summary     string               `json:"summary" valid:"required~summary_is_required" example:"Postmortem for {{incident}}"`
+	Description string               `json:"description" example:"## Timeline\n\n## Root cause\n\n## Action items"`
+	Priority    *taskEntity.Priority `json:"priority" valid:"optional,in(lowest|low|medium|high|highest)~invalid_priority" example:"high"`
+	Labels      []string             `json:"labels" example:"postmortem"`
+	Checklist   []string             `json:"checklist" example:"Collect logs"`
+}
+
+func (s *Service) Create(req *CreateRequest) (*aggregate.TemplateResponse, error) {
+	existing, err := s.repository.FindByName(req.Name)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.logger.Error("error finding template", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	if existing.ID != 0 {
+		s.logger.Error("template already exists", "name", req.Name)
+		return nil, apperror.Conflict("template_already_exists")
+	}
+
+	priority := taskEntity.PriorityMedium
+	if req.Priority != nil {
+		priority = *req.Priority
+	}
+
+	e := &entity.Template{
+		Name:        req.Name,
+		Summary:     req.Summary,
+		Description: req.Description,
+		Priority:    priority,
+		Labels:      req.Labels,
+		Checklist:   req.Checklist,
+	}
+
+	err = s.repository.Create(e)
+	if err != nil {
+		// Another template with the name was created in the meantime
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, apperror.Conflict("template_already_exists").Wrap(err)
+		}
+		s.logger.Error("error creating template", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewTemplateResponse(e), nil
+}
+
+// ********************* Update *********************
+type UpdateRequest struct {
+	Summary     string              `json:"summary" valid:"required~summary_is_required" example:"Postmortem for {{incident}}"`
+	Description string              `json:"description" example:"## Timeline\n\n## Root cause\n\n## Action items"`
+	Priority    taskEntity.Priority `json:"priority" valid:"required~priority_is_required,in(lowest|low|medium|high|highest)~invalid_priority" example:"high"`
+	Labels      []string            `json:"labels" example:"postmortem"`
+	Checklist   []string            `json:"checklist" example:"Collect logs"`
+}
+
+func (s *Service) Update(req *UpdateRequest, id string) (*aggregate.TemplateResponse, error) {
+	e, err := s.findByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	e.Summary = req.Summary
+	e.Description = req.Description
+	e.Priority = req.Priority
+	e.Labels = req.Labels
+	e.Checklist = req.Checklist
+
+	err = s.repository.Update(e)
+	if err != nil {
+		s.logger.Error("error updating template", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewTemplateResponse(&e), nil
+}
+
+// ********************* Find By ID *********************
+func (s *Service) FindByID(id string) (*aggregate.TemplateResponse, error) {
+	e, err := s.findByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggregate.NewTemplateResponse(&e), nil
+}
+
+// ********************* Find All *********************
+func (s *Service) FindAll(page, limit int) (*aggregate.TemplateListResponse, error) {
+	templates, count, err := s.repository.FindAll(page, limit)
+	if err != nil {
+		s.logger.Error("error finding templates", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewTemplateListResponse(templates, page, limit, count, ""), nil
+}
+
+// ********************* Delete *********************
+func (s *Service) Delete(id string) error {
+	e, err := s.findByID(id)
+	if err != nil {
+		return err
+	}
+
+	err = s.repository.Delete(e)
+	if err != nil {
+		s.logger.Error("error deleting template", "error", err)
+		return apperror.Internal(err)
+	}
+
+	return nil
+}
+
+// ********************* Create Task From Template *********************
+type CreateTaskRequest struct {
+	Assignee  string               `json:"assignee" example:"admin"`
+	Priority  *taskEntity.Priority `json:"priority" example:"high"`
+	DueDate   *time.Time           `json:"due_date" example:"2025-01-01T00:00:00Z"`
+	Variables map[string]string    `json:"variables"`
+}
+
+// CreateTask creates a task from the template, substituting {{name}} placeholders with the request variables
+// The resulting request goes through the same validation as a regular task creation
+func (s *Service) CreateTask(id string, req *CreateTaskRequest, userID uint) error {
+	e, err := s.findByID(id)
+	if err != nil {
+		return err
+	}
+
+	summary, err := entity.Render(e.Summary, req.Variables)
+	if err != nil {
+		s.logger.Error("error rendering template summary", "error", err)
+		return apperror.Validation("missing_template_variables")
+	}
+
+	description, err := entity.Render(e.Description, req.Variables)
+	if err != nil {
+		s.logger.Error("error rendering template description", "error", err)
+		return apperror.Validation("missing_template_variables")
+	}
+
+	priority := e.Priority
+	if req.Priority != nil {
+		priority = *req.Priority
+	}
+
+	createReq := &task.CreateRequest{
+		Summary:     summary,
+		Description: description,
+		Assignee:    req.Assignee,
+		Priority:    &priority,
+		DueDate:     req.DueDate,
+		Labels:      e.Labels,
+	}
+
+	if _, err := govalidator.ValidateStruct(createReq); err != nil {
+		s.logger.Error("error validating request", "error", err)
+		return apperror.Validation(err.Error())
+	}
+
+	t, err := s.taskService.CreateEntity(createReq, userID)
+	if err != nil {
+		return err
+	}
+
+	return s.checklistService.CreateDefaults(t.ID, e.Checklist)
+}
+
+// Helper functions
+func (s *Service) findByID(id string) (entity.Template, error) {
+	uintID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		s.logger.Error("error parsing id", "error", err)
+		return entity.Template{}, apperror.BadRequest("invalid_id")
+	}
+
+	e, err := s.repository.FindByID(uint(uintID))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding template", "error", err)
+			return entity.Template{}, apperror.Internal(err)
+		}
+		s.logger.Error("template not found", "error", err)
+		return entity.Template{}, apperror.NotFound("template_not_found")
+	}
+
+	return e, nil
+}
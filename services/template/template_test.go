@@ -0,0 +1,197 @@
+package template
+
+import (
+	"errors"
+	"net/http"
+	checklistEntity "task_mng/domain/checklist/entity"
+	checklistMocks "task_mng/domain/checklist/mocks"
+	projectMocks "task_mng/domain/project/mocks"
+	taskEntity "task_mng/domain/task/entity"
+	taskMocks "task_mng/domain/task/mocks"
+	"task_mng/domain/template/entity"
+	"task_mng/domain/template/mocks"
+	userEntity "task_mng/domain/user/entity"
+	userMocks "task_mng/domain/user/mocks"
+	"task_mng/pkg/apperror"
+	notifierMocks "task_mng/pkg/notifier/mocks"
+	redisMocks "task_mng/pkg/redis/mocks"
+	storageMocks "task_mng/pkg/storage/mocks"
+	"task_mng/services/checklist"
+	"task_mng/services/task"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func newTestService() (*Service, *mocks.MockTemplateRepository, *taskMocks.MockTaskRepository, *userMocks.MockUserRepository) {
+	mockRepo := new(mocks.MockTemplateRepository)
+	mockTaskRepo := new(taskMocks.MockTaskRepository)
+	mockUserRepo := new(userMocks.MockUserRepository)
+
+	// Mock CountByStatus for metrics updates of the task service
+	mockTaskRepo.On("CountByStatus").Return(map[taskEntity.Status]int64{}, nil)
+
+	taskService := task.New(mockTaskRepo, &redisMocks.MockRedisClient{}, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
+	checklistService := checklist.New(new(checklistMocks.MockChecklistRepository), mockTaskRepo, taskService, mockUserRepo)
+
+	return New(mockRepo, taskService, checklistService), mockRepo, mockTaskRepo, mockUserRepo
+}
+
+func postmortemTemplate() entity.Template {
+	return entity.Template{
+		Model:       gorm.Model{ID: 1},
+		Name:        "incident-postmortem",
+		Summary:     "Postmortem for {{incident}}",
+		Description: "Customer: {{customer}}",
+		Priority:    taskEntity.PriorityHigh,
+		Labels:      []string{"postmortem"},
+	}
+}
+
+func TestCreate_NameTakenConcurrently(t *testing.T) {
+	service, mockRepo, _, _ := newTestService()
+
+	// the name was free when it was checked but the insert hit the unique index
+	mockRepo.On("FindByName", "incident-postmortem").Return(entity.Template{}, gorm.ErrRecordNotFound)
+	mockRepo.On("Create", mock.AnythingOfType("*entity.Template")).Return(gorm.ErrDuplicatedKey)
+
+	_, err := service.Create(&CreateRequest{Name: "incident-postmortem", Summary: "Postmortem"})
+
+	var appErr *apperror.Error
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, http.StatusConflict, appErr.Status)
+	assert.Equal(t, "template_already_exists", appErr.Code)
+}
+
+func TestCreateTask_Success(t *testing.T) {
+	service, mockRepo, mockTaskRepo, mockUserRepo := newTestService()
+
+	mockRepo.On("FindByID", uint(1)).Return(postmortemTemplate(), nil)
+
+	mockUserRepo.On("FindByUsername", "admin").Return(userEntity.User{
+		Model:    gorm.Model{ID: 1},
+		Username: "admin",
+	}, nil)
+
+	mockTaskRepo.On("Create", mock.MatchedBy(func(t *taskEntity.Task) bool {
+		return t.Summary == "Postmortem for INC-42" &&
+			t.Description == "Customer: ACME" &&
+			t.Priority == taskEntity.PriorityHigh &&
+			t.Assignee == 1 &&
+			len(t.Labels) == 1 && t.Labels[0] == "postmortem"
+	})).Return(nil)
+	mockTaskRepo.On("AddWatchers", uint(0), []uint{3, 1}).Return(nil)
+
+	err := service.CreateTask("1", &CreateTaskRequest{
+		Assignee: "admin",
+		Variables: map[string]string{
+			"incident": "INC-42",
+			"customer": "ACME",
+		},
+	}, 3)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockTaskRepo.AssertExpectations(t)
+	mockUserRepo.AssertExpectations(t)
+}
+
+func TestCreateTask_WithChecklistDefaults(t *testing.T) {
+	mockRepo := new(mocks.MockTemplateRepository)
+	mockTaskRepo := new(taskMocks.MockTaskRepository)
+	mockUserRepo := new(userMocks.MockUserRepository)
+	mockChecklistRepo := new(checklistMocks.MockChecklistRepository)
+
+	mockTaskRepo.On("CountByStatus").Return(map[taskEntity.Status]int64{}, nil)
+
+	taskService := task.New(mockTaskRepo, &redisMocks.MockRedisClient{}, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
+	service := New(mockRepo, taskService, checklist.New(mockChecklistRepo, mockTaskRepo, taskService, mockUserRepo))
+
+	tmpl := postmortemTemplate()
+	tmpl.Checklist = []string{"Collect logs", "Write timeline"}
+	mockRepo.On("FindByID", uint(1)).Return(tmpl, nil)
+
+	mockUserRepo.On("FindByUsername", "admin").Return(userEntity.User{
+		Model:    gorm.Model{ID: 1},
+		Username: "admin",
+	}, nil)
+
+	mockTaskRepo.On("Create", mock.AnythingOfType("*entity.Task")).Run(func(args mock.Arguments) {
+		args.Get(0).(*taskEntity.Task).ID = 7
+	}).Return(nil)
+	mockTaskRepo.On("AddWatchers", uint(7), mock.Anything).Return(nil)
+
+	mockChecklistRepo.On("CreateMany", mock.MatchedBy(func(items []checklistEntity.Item) bool {
+		return len(items) == 2 &&
+			items[0].TaskID == 7 && items[0].Text == "Collect logs" && items[0].Position == 0 &&
+			items[1].TaskID == 7 && items[1].Text == "Write timeline" && items[1].Position == 1
+	})).Return(nil)
+
+	mockTaskRepo.On("UpdateFields", uint(7), map[string]interface{}{
+		"checklist_total": 2,
+		"checklist_done":  0,
+	}).Return(nil)
+
+	err := service.CreateTask("1", &CreateTaskRequest{
+		Assignee: "admin",
+		Variables: map[string]string{
+			"incident": "INC-42",
+			"customer": "ACME",
+		},
+	}, 3)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockTaskRepo.AssertExpectations(t)
+	mockChecklistRepo.AssertExpectations(t)
+}
+
+func TestCreateTask_MissingVariables(t *testing.T) {
+	service, mockRepo, mockTaskRepo, mockUserRepo := newTestService()
+
+	mockRepo.On("FindByID", uint(1)).Return(postmortemTemplate(), nil)
+
+	err := service.CreateTask("1", &CreateTaskRequest{
+		Assignee:  "admin",
+		Variables: map[string]string{"incident": "INC-42"},
+	}, 3)
+
+	assert.Error(t, err)
+	assert.Equal(t, "missing_template_variables", err.Error())
+	mockRepo.AssertExpectations(t)
+	mockTaskRepo.AssertNotCalled(t, "Create", mock.Anything)
+	mockUserRepo.AssertExpectations(t)
+}
+
+func TestCreateTask_ReusesTaskValidation(t *testing.T) {
+	service, mockRepo, mockTaskRepo, mockUserRepo := newTestService()
+
+	mockRepo.On("FindByID", uint(1)).Return(postmortemTemplate(), nil)
+
+	err := service.CreateTask("1", &CreateTaskRequest{
+		Variables: map[string]string{
+			"incident": "INC-42",
+			"customer": "ACME",
+		},
+	}, 3)
+
+	assert.Error(t, err)
+	assert.Equal(t, "assignee_is_required", err.Error())
+	mockRepo.AssertExpectations(t)
+	mockTaskRepo.AssertNotCalled(t, "Create", mock.Anything)
+	mockUserRepo.AssertExpectations(t)
+}
+
+func TestCreateTask_TemplateNotFound(t *testing.T) {
+	service, mockRepo, _, _ := newTestService()
+
+	mockRepo.On("FindByID", uint(2)).Return(entity.Template{}, gorm.ErrRecordNotFound)
+
+	err := service.CreateTask("2", &CreateTaskRequest{Assignee: "admin"}, 3)
+
+	assert.Error(t, err)
+	assert.Equal(t, "template_not_found", err.Error())
+	mockRepo.AssertExpectations(t)
+}
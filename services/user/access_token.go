@@ -0,0 +1,187 @@
+package user
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"task_mng/domain/user/aggregate"
+	"task_mng/domain/user/entity"
+	"task_mng/pkg/apperror"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// accessTokenTouchInterval limits how often the last use of a token is written, it isn't updated on every request
+const accessTokenTouchInterval = time.Minute
+
+// ********************* Create Access Token *********************
+type CreateAccessTokenRequest struct {
+	Name   string   `json:"name" valid:"required~name_is_required,length(1|64)~name_must_be_at_most_64_characters" example:"CI bot"`
+	Scopes []string `json:"scopes" example:"read,write"`
+	// ExpiresAt is optional, tokens without it don't expire
+	ExpiresAt *time.Time `json:"expires_at" example:"2026-01-01T00:00:00Z"`
+}
+
+// CreateAccessToken creates a personal access token for the user, the token is only returned here
+func (s *Service) CreateAccessToken(userID uint, req *CreateAccessTokenRequest) (*aggregate.CreatedAccessTokenResponse, error) {
+	usr, err := s.repository.FindByID(userID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding user", "error", err)
+			return nil, apperror.Internal(err)
+		}
+		return nil, apperror.NotFound("user_not_found").Wrap(err)
+	}
+
+	scopes, err := normalizeScopes(req.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, scope := range scopes {
+		if scope == entity.ScopeAdmin && usr.Role != entity.RoleAdmin {
+			return nil, apperror.Forbidden("admin_required")
+		}
+	}
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, apperror.Validation("expires_at_must_be_in_the_future")
+	}
+
+	secret, err := newToken()
+	if err != nil {
+		s.logger.Error("error generating access token", "error", err)
+		return nil, apperror.Internal(err)
+	}
+	token := entity.AccessTokenPrefix + secret
+
+	accessToken := &entity.AccessToken{
+		UserID:    usr.ID,
+		Name:      req.Name,
+		Scopes:    scopes,
+		Hint:      token[len(token)-4:],
+		TokenHash: hashToken(token),
+		ExpiresAt: req.ExpiresAt,
+	}
+	if err := s.repository.CreateAccessToken(accessToken); err != nil {
+		s.logger.Error("error creating access token", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return &aggregate.CreatedAccessTokenResponse{
+		AccessTokenResponse: aggregate.NewAccessTokenResponse(accessToken),
+		Token:               token,
+	}, nil
+}
+
+// ********************* Find Access Tokens *********************
+
+// FindAccessTokens returns the access tokens of the user that aren't revoked
+func (s *Service) FindAccessTokens(userID uint) ([]*aggregate.AccessTokenResponse, error) {
+	tokens, err := s.repository.FindAccessTokens(userID)
+	if err != nil {
+		s.logger.Error("error finding access tokens", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewAccessTokenListResponse(tokens), nil
+}
+
+// ********************* Revoke Access Token *********************
+
+// RevokeAccessToken revokes an access token of the user, it can't be used from then on
+func (s *Service) RevokeAccessToken(userID uint, id string) error {
+	uintID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		s.logger.Error("error parsing id", "error", err)
+		return apperror.BadRequest("invalid_id")
+	}
+
+	revoked, err := s.repository.RevokeAccessToken(userID, uint(uintID))
+	if err != nil {
+		s.logger.Error("error revoking access token", "error", err)
+		return apperror.Internal(err)
+	}
+	if !revoked {
+		return apperror.NotFound("access_token_not_found")
+	}
+
+	return nil
+}
+
+// ********************* Authenticate Access Token *********************
+
+// AuthenticateAccessToken finds the user of a personal access token and records that the token was used
+func (s *Service) AuthenticateAccessToken(token string) (entity.User, entity.AccessToken, error) {
+	accessToken, err := s.repository.FindAccessToken(hashToken(token))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding access token", "error", err)
+			return entity.User{}, entity.AccessToken{}, apperror.Internal(err)
+		}
+		return entity.User{}, entity.AccessToken{}, apperror.Unauthorized("invalid_access_token")
+	}
+
+	now := time.Now().UTC()
+	if accessToken.RevokedAt != nil {
+		return entity.User{}, entity.AccessToken{}, apperror.Unauthorized("invalid_access_token")
+	}
+	if !accessToken.IsActive(now) {
+		return entity.User{}, entity.AccessToken{}, apperror.Unauthorized("access_token_expired")
+	}
+
+	usr, err := s.repository.FindByID(accessToken.UserID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding user", "error", err)
+			return entity.User{}, entity.AccessToken{}, apperror.Internal(err)
+		}
+		return entity.User{}, entity.AccessToken{}, apperror.Unauthorized("invalid_access_token")
+	}
+
+	if accessToken.LastUsedAt == nil || now.Sub(*accessToken.LastUsedAt) >= accessTokenTouchInterval {
+		if err := s.repository.TouchAccessToken(accessToken.ID, now); err != nil {
+			s.logger.Error("error recording access token use", "error", err)
+		}
+		accessToken.LastUsedAt = &now
+	}
+
+	return usr, accessToken, nil
+}
+
+// Helper functions
+
+// normalizeScopes checks the requested scopes and drops duplicates
+func normalizeScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, apperror.Validation("scopes_are_required")
+	}
+
+	normalized := make([]string, 0, len(scopes))
+	seen := make(map[string]bool, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.ToLower(strings.TrimSpace(scope))
+		if !isScope(scope) {
+			return nil, apperror.Validation("invalid_scope").WithDetails(map[string]interface{}{
+				"scope":  scope,
+				"scopes": entity.Scopes,
+			})
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			normalized = append(normalized, scope)
+		}
+	}
+
+	return normalized, nil
+}
+
+func isScope(scope string) bool {
+	for _, s := range entity.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,167 @@
+package user
+
+import (
+	"strings"
+	"task_mng/domain/user/entity"
+	"task_mng/domain/user/mocks"
+	jwtMocks "task_mng/pkg/jwt/mocks"
+	notifierMocks "task_mng/pkg/notifier/mocks"
+	redisMocks "task_mng/pkg/redis/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func newAccessTokenTestService() (*Service, *mocks.MockUserRepository) {
+	mockRepo := new(mocks.MockUserRepository)
+	return New(mockRepo, &jwtMocks.MockJWTManager{}, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{}), mockRepo
+}
+
+func accessTokenTestUser(role string) entity.User {
+	return entity.User{Model: gorm.Model{ID: 1}, Username: "bot", Role: role}
+}
+
+// ********************* Create Access Token Tests *********************
+
+func TestCreateAccessToken_Success(t *testing.T) {
+	service, mockRepo := newAccessTokenTestService()
+
+	var stored *entity.AccessToken
+	mockRepo.On("FindByID", uint(1)).Return(accessTokenTestUser(entity.RoleUser), nil)
+	mockRepo.On("CreateAccessToken", mock.AnythingOfType("*entity.AccessToken")).
+		Run(func(args mock.Arguments) { stored = args.Get(0).(*entity.AccessToken) }).
+		Return(nil)
+
+	resp, err := service.CreateAccessToken(1, &CreateAccessTokenRequest{
+		Name:   "CI bot",
+		Scopes: []string{"read", "Write", "read"},
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(resp.Token, entity.AccessTokenPrefix))
+	assert.Equal(t, []string{entity.ScopeRead, entity.ScopeWrite}, stored.Scopes)
+	assert.Equal(t, uint(1), stored.UserID)
+	assert.Nil(t, stored.ExpiresAt)
+
+	// Only the hash of the token is stored
+	assert.Equal(t, hashToken(resp.Token), stored.TokenHash)
+	assert.True(t, strings.HasSuffix(resp.Token, stored.Hint))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateAccessToken_InvalidScope(t *testing.T) {
+	service, mockRepo := newAccessTokenTestService()
+
+	mockRepo.On("FindByID", uint(1)).Return(accessTokenTestUser(entity.RoleUser), nil)
+
+	_, err := service.CreateAccessToken(1, &CreateAccessTokenRequest{Name: "CI bot", Scopes: []string{"delete"}})
+
+	assert.Equal(t, "invalid_scope", err.Error())
+	mockRepo.AssertNotCalled(t, "CreateAccessToken", mock.Anything)
+}
+
+func TestCreateAccessToken_AdminScopeRequiresAdmin(t *testing.T) {
+	service, mockRepo := newAccessTokenTestService()
+
+	mockRepo.On("FindByID", uint(1)).Return(accessTokenTestUser(entity.RoleUser), nil)
+
+	_, err := service.CreateAccessToken(1, &CreateAccessTokenRequest{Name: "CI bot", Scopes: []string{"admin"}})
+
+	assert.Equal(t, "admin_required", err.Error())
+	mockRepo.AssertNotCalled(t, "CreateAccessToken", mock.Anything)
+}
+
+func TestCreateAccessToken_ExpiryInThePast(t *testing.T) {
+	service, mockRepo := newAccessTokenTestService()
+
+	expiresAt := time.Now().Add(-time.Hour)
+	mockRepo.On("FindByID", uint(1)).Return(accessTokenTestUser(entity.RoleUser), nil)
+
+	_, err := service.CreateAccessToken(1, &CreateAccessTokenRequest{Name: "CI bot", Scopes: []string{"read"}, ExpiresAt: &expiresAt})
+
+	assert.Equal(t, "expires_at_must_be_in_the_future", err.Error())
+}
+
+// ********************* Revoke Access Token Tests *********************
+
+func TestRevokeAccessToken_NotFound(t *testing.T) {
+	service, mockRepo := newAccessTokenTestService()
+
+	// the token belongs to someone else or is revoked already
+	mockRepo.On("RevokeAccessToken", uint(1), uint(5)).Return(false, nil)
+
+	err := service.RevokeAccessToken(1, "5")
+
+	assert.Equal(t, "access_token_not_found", err.Error())
+}
+
+// ********************* Authenticate Access Token Tests *********************
+
+func TestAuthenticateAccessToken_Success(t *testing.T) {
+	service, mockRepo := newAccessTokenTestService()
+
+	token := entity.AccessTokenPrefix + "secret"
+	mockRepo.On("FindAccessToken", hashToken(token)).Return(entity.AccessToken{ID: 3, UserID: 1, Scopes: []string{"read"}}, nil)
+	mockRepo.On("FindByID", uint(1)).Return(accessTokenTestUser(entity.RoleUser), nil)
+	mockRepo.On("TouchAccessToken", uint(3), mock.AnythingOfType("time.Time")).Return(nil)
+
+	usr, accessToken, err := service.AuthenticateAccessToken(token)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), usr.ID)
+	assert.NotNil(t, accessToken.LastUsedAt)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestAuthenticateAccessToken_RecentlyUsed(t *testing.T) {
+	service, mockRepo := newAccessTokenTestService()
+
+	token := entity.AccessTokenPrefix + "secret"
+	lastUsedAt := time.Now().UTC().Add(-10 * time.Second)
+	mockRepo.On("FindAccessToken", hashToken(token)).Return(entity.AccessToken{ID: 3, UserID: 1, LastUsedAt: &lastUsedAt}, nil)
+	mockRepo.On("FindByID", uint(1)).Return(accessTokenTestUser(entity.RoleUser), nil)
+
+	_, _, err := service.AuthenticateAccessToken(token)
+
+	assert.NoError(t, err)
+	mockRepo.AssertNotCalled(t, "TouchAccessToken", mock.Anything, mock.Anything)
+}
+
+func TestAuthenticateAccessToken_Expired(t *testing.T) {
+	service, mockRepo := newAccessTokenTestService()
+
+	token := entity.AccessTokenPrefix + "secret"
+	expiresAt := time.Now().UTC().Add(-time.Minute)
+	mockRepo.On("FindAccessToken", hashToken(token)).Return(entity.AccessToken{ID: 3, UserID: 1, ExpiresAt: &expiresAt}, nil)
+
+	_, _, err := service.AuthenticateAccessToken(token)
+
+	assert.Equal(t, "access_token_expired", err.Error())
+	mockRepo.AssertNotCalled(t, "FindByID", mock.Anything)
+}
+
+func TestAuthenticateAccessToken_Revoked(t *testing.T) {
+	service, mockRepo := newAccessTokenTestService()
+
+	token := entity.AccessTokenPrefix + "secret"
+	revokedAt := time.Now().UTC().Add(-time.Minute)
+	mockRepo.On("FindAccessToken", hashToken(token)).Return(entity.AccessToken{ID: 3, UserID: 1, RevokedAt: &revokedAt}, nil)
+
+	_, _, err := service.AuthenticateAccessToken(token)
+
+	assert.Equal(t, "invalid_access_token", err.Error())
+}
+
+func TestAuthenticateAccessToken_Unknown(t *testing.T) {
+	service, mockRepo := newAccessTokenTestService()
+
+	token := entity.AccessTokenPrefix + "secret"
+	mockRepo.On("FindAccessToken", hashToken(token)).Return(entity.AccessToken{}, gorm.ErrRecordNotFound)
+
+	_, _, err := service.AuthenticateAccessToken(token)
+
+	assert.Equal(t, "invalid_access_token", err.Error())
+}
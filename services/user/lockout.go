@@ -0,0 +1,191 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"task_mng/domain/user/entity"
+	"task_mng/pkg/apperror"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+const loginFailuresKeyPrefix = "login:failures:ip"
+
+// LockoutConfig controls the brute-force protection of logins, a zero value disables it
+type LockoutConfig struct {
+	// MaxAttempts is how many failed logins in a row lock an account
+	MaxAttempts int
+	// Duration is how long the first lockout lasts, every further lockout lasts twice as long
+	Duration time.Duration
+	// MaxDuration caps the lockout duration
+	MaxDuration time.Duration
+	// IPMaxAttempts is how many failed logins an IP can make within IPWindow before it is blocked
+	IPMaxAttempts int
+	IPWindow      time.Duration
+}
+
+// ********************* Login attempts *********************
+
+// checkIP rejects logins from addresses that failed too often recently
+func (s *Service) checkIP(ip string) error {
+	if s.config.Lockout.IPMaxAttempts <= 0 || ip == "" {
+		return nil
+	}
+
+	value, err := s.redis.Get(context.Background(), loginFailuresKey(ip))
+	if err != nil {
+		if !errors.Is(err, goredis.Nil) {
+			s.logger.Error("error getting failed logins of ip", "error", err)
+		}
+		return nil
+	}
+
+	if failures, _ := strconv.Atoi(value); failures >= s.config.Lockout.IPMaxAttempts {
+		s.logger.Warn("login blocked for ip", "ip", ip, "failures", failures)
+		return apperror.TooManyRequests("too_many_failed_logins")
+	}
+
+	return nil
+}
+
+// recordFailedLogin counts a failed login for the IP and the user, locking the user after too many failures
+// It returns the error to answer the login with
+func (s *Service) recordFailedLogin(usr *entity.User, ip string) error {
+	incorrect := apperror.Unauthorized("username_or_password_is_incorrect")
+
+	if s.config.Lockout.IPMaxAttempts > 0 && ip != "" {
+		if _, err := s.redis.IncrExpire(context.Background(), loginFailuresKey(ip), s.config.Lockout.IPWindow); err != nil {
+			s.logger.Error("error counting failed login of ip", "error", err)
+		}
+	}
+
+	if s.config.Lockout.MaxAttempts <= 0 || usr.ID == 0 {
+		return incorrect
+	}
+
+	// The count is incremented in the database, concurrent failures would overwrite each other otherwise
+	failures, err := s.repository.IncrementFailedLogins(usr.ID)
+	if err != nil {
+		s.logger.Error("error counting failed login", "error", err)
+		return incorrect
+	}
+	if failures < s.config.Lockout.MaxAttempts {
+		return incorrect
+	}
+
+	// Concurrent failures past the limit all lock the user, they read the same lockouts so it's only counted once
+	lockedUntil := time.Now().UTC().Add(s.lockoutDuration(usr.Lockouts))
+	err = s.repository.UpdateFields(usr.ID, map[string]interface{}{
+		"failed_logins": 0,
+		"lockouts":      usr.Lockouts + 1,
+		"locked_until":  lockedUntil,
+	})
+	if err != nil {
+		s.logger.Error("error locking user", "error", err)
+		return incorrect
+	}
+
+	s.logger.Warn("user locked after failed logins", "user_id", usr.ID, "ip", ip, "locked_until", lockedUntil)
+	s.recordLockoutEvent(&entity.LockoutEvent{
+		UserID:      usr.ID,
+		Event:       entity.LockoutEventLocked,
+		IP:          ip,
+		LockedUntil: &lockedUntil,
+	})
+
+	return accountLocked(lockedUntil)
+}
+
+// resetFailedLogins forgets the failed logins and lockouts of a user after a successful login
+func (s *Service) resetFailedLogins(usr *entity.User) {
+	if usr.FailedLogins == 0 && usr.Lockouts == 0 && usr.LockedUntil == nil {
+		return
+	}
+
+	err := s.repository.UpdateFields(usr.ID, map[string]interface{}{
+		"failed_logins": 0,
+		"lockouts":      0,
+		"locked_until":  nil,
+	})
+	if err != nil {
+		s.logger.Error("error resetting failed logins", "error", err)
+	}
+}
+
+// lockoutDuration doubles the lockout duration with every previous lockout
+func (s *Service) lockoutDuration(lockouts int) time.Duration {
+	duration := s.config.Lockout.Duration
+	for i := 0; i < lockouts; i++ {
+		if s.config.Lockout.MaxDuration > 0 && duration >= s.config.Lockout.MaxDuration {
+			break
+		}
+		duration *= 2
+	}
+	if s.config.Lockout.MaxDuration > 0 && duration > s.config.Lockout.MaxDuration {
+		duration = s.config.Lockout.MaxDuration
+	}
+	return duration
+}
+
+// ********************* Unlock *********************
+
+// Unlock lifts the lockout of a user and forgets its failed logins
+func (s *Service) Unlock(id string, adminID uint) error {
+	uintID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		s.logger.Error("error parsing id", "error", err)
+		return apperror.BadRequest("invalid_id")
+	}
+
+	usr, err := s.repository.FindByID(uint(uintID))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding user", "error", err)
+			return apperror.Internal(err)
+		}
+		return apperror.NotFound("user_not_found").Wrap(err)
+	}
+
+	if !usr.IsLocked(time.Now().UTC()) && usr.FailedLogins == 0 {
+		return apperror.Conflict("user_not_locked")
+	}
+
+	err = s.repository.UpdateFields(usr.ID, map[string]interface{}{
+		"failed_logins": 0,
+		"lockouts":      0,
+		"locked_until":  nil,
+	})
+	if err != nil {
+		s.logger.Error("error unlocking user", "error", err)
+		return apperror.Internal(err)
+	}
+
+	s.logger.Info("user unlocked", "user_id", usr.ID, "admin_id", adminID)
+	s.recordLockoutEvent(&entity.LockoutEvent{
+		UserID:  usr.ID,
+		Event:   entity.LockoutEventUnlocked,
+		ActorID: &adminID,
+	})
+
+	return nil
+}
+
+// Helper functions
+
+func (s *Service) recordLockoutEvent(event *entity.LockoutEvent) {
+	if err := s.repository.CreateLockoutEvent(event); err != nil {
+		s.logger.Error("error recording lockout event", "error", err)
+	}
+}
+
+func accountLocked(until time.Time) error {
+	return apperror.Locked("account_locked").WithDetails(map[string]time.Time{"locked_until": until})
+}
+
+func loginFailuresKey(ip string) string {
+	return fmt.Sprintf("%s:%s", loginFailuresKeyPrefix, ip)
+}
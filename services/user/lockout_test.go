@@ -0,0 +1,188 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"task_mng/domain/user/entity"
+	"task_mng/domain/user/mocks"
+	"task_mng/pkg/apperror"
+	jwtMocks "task_mng/pkg/jwt/mocks"
+	notifierMocks "task_mng/pkg/notifier/mocks"
+	redisMocks "task_mng/pkg/redis/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+var testLockoutConfig = LockoutConfig{
+	MaxAttempts:   3,
+	Duration:      time.Minute,
+	MaxDuration:   time.Hour,
+	IPMaxAttempts: 10,
+	IPWindow:      15 * time.Minute,
+}
+
+func newLockoutTestService(redisMock *redisMocks.MockRedisClient) (*Service, *mocks.MockUserRepository) {
+	mockRepo := new(mocks.MockUserRepository)
+	return New(mockRepo, &jwtMocks.MockJWTManager{}, &jwtMocks.MockSessionRevoker{}, redisMock, &notifierMocks.MockNotifier{}, Config{Lockout: testLockoutConfig}), mockRepo
+}
+
+func lockoutTestUser(failedLogins, lockouts int) entity.User {
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("correct_password"), bcrypt.MinCost)
+	return entity.User{
+		Model:        gorm.Model{ID: 1},
+		Username:     "user",
+		Password:     string(hashedPassword),
+		FailedLogins: failedLogins,
+		Lockouts:     lockouts,
+	}
+}
+
+func TestLogin_CountsFailedAttempt(t *testing.T) {
+	var ipKey string
+	service, mockRepo := newLockoutTestService(&redisMocks.MockRedisClient{
+		GetFunc: func(ctx context.Context, key string) (string, error) { return "2", nil },
+		IncrExpireFunc: func(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+			ipKey = key
+			return 3, nil
+		},
+	})
+
+	mockRepo.On("FindByUsername", "user").Return(lockoutTestUser(1, 0), nil)
+	mockRepo.On("IncrementFailedLogins", uint(1)).Return(2, nil)
+
+	_, err := service.Login(&LoginRequest{Username: "user", Password: "wrong_password", IP: "1.2.3.4"})
+
+	assert.Equal(t, "username_or_password_is_incorrect", err.Error())
+	assert.Equal(t, "login:failures:ip:1.2.3.4", ipKey)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestLogin_LocksAfterMaxAttempts(t *testing.T) {
+	service, mockRepo := newLockoutTestService(&redisMocks.MockRedisClient{})
+
+	mockRepo.On("FindByUsername", "user").Return(lockoutTestUser(2, 2), nil)
+	mockRepo.On("IncrementFailedLogins", uint(1)).Return(3, nil)
+	mockRepo.On("UpdateFields", uint(1), mock.MatchedBy(func(fields map[string]interface{}) bool {
+		lockedUntil, ok := fields["locked_until"].(time.Time)
+		// the third lockout lasts four times the base duration
+		return ok && fields["failed_logins"] == 0 && fields["lockouts"] == 3 &&
+			time.Until(lockedUntil) > 3*time.Minute && time.Until(lockedUntil) <= 4*time.Minute
+	})).Return(nil)
+	mockRepo.On("CreateLockoutEvent", mock.MatchedBy(func(e *entity.LockoutEvent) bool {
+		return e.UserID == 1 && e.Event == entity.LockoutEventLocked && e.IP == "1.2.3.4" && e.LockedUntil != nil
+	})).Return(nil)
+
+	_, err := service.Login(&LoginRequest{Username: "user", Password: "wrong_password", IP: "1.2.3.4"})
+
+	var appErr *apperror.Error
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, http.StatusLocked, appErr.Status)
+	assert.Equal(t, "account_locked", appErr.Code)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestLogin_LocksOnConcurrentFailures(t *testing.T) {
+	service, mockRepo := newLockoutTestService(&redisMocks.MockRedisClient{})
+
+	// the user was read before another failed login was counted
+	mockRepo.On("FindByUsername", "user").Return(lockoutTestUser(1, 0), nil)
+	mockRepo.On("IncrementFailedLogins", uint(1)).Return(3, nil)
+	mockRepo.On("UpdateFields", uint(1), mock.MatchedBy(func(fields map[string]interface{}) bool {
+		return fields["failed_logins"] == 0 && fields["lockouts"] == 1
+	})).Return(nil)
+	mockRepo.On("CreateLockoutEvent", mock.Anything).Return(nil)
+
+	_, err := service.Login(&LoginRequest{Username: "user", Password: "wrong_password", IP: "1.2.3.4"})
+
+	assert.Equal(t, "account_locked", err.Error())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestLogin_LockedUser(t *testing.T) {
+	service, mockRepo := newLockoutTestService(&redisMocks.MockRedisClient{})
+
+	usr := lockoutTestUser(0, 1)
+	lockedUntil := time.Now().UTC().Add(time.Minute)
+	usr.LockedUntil = &lockedUntil
+	mockRepo.On("FindByUsername", "user").Return(usr, nil)
+
+	// Even the correct password is rejected while the account is locked
+	_, err := service.Login(&LoginRequest{Username: "user", Password: "correct_password", IP: "1.2.3.4"})
+
+	assert.Equal(t, "account_locked", err.Error())
+	mockRepo.AssertNotCalled(t, "UpdateFields", mock.Anything, mock.Anything)
+}
+
+func TestLogin_BlockedIP(t *testing.T) {
+	service, mockRepo := newLockoutTestService(&redisMocks.MockRedisClient{
+		GetFunc: func(ctx context.Context, key string) (string, error) { return "10", nil },
+	})
+
+	_, err := service.Login(&LoginRequest{Username: "user", Password: "correct_password", IP: "1.2.3.4"})
+
+	assert.Equal(t, "too_many_failed_logins", err.Error())
+	mockRepo.AssertNotCalled(t, "FindByUsername", mock.Anything)
+}
+
+func TestLogin_ResetsFailedAttempts(t *testing.T) {
+	service, mockRepo := newLockoutTestService(&redisMocks.MockRedisClient{})
+
+	mockRepo.On("FindByUsername", "user").Return(lockoutTestUser(2, 1), nil)
+	mockRepo.On("UpdateFields", uint(1), map[string]interface{}{
+		"failed_logins": 0,
+		"lockouts":      0,
+		"locked_until":  nil,
+	}).Return(nil)
+
+	_, err := service.Login(&LoginRequest{Username: "user", Password: "correct_password", IP: "1.2.3.4"})
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestLockoutDuration_IsCapped(t *testing.T) {
+	service, _ := newLockoutTestService(&redisMocks.MockRedisClient{})
+
+	assert.Equal(t, time.Minute, service.lockoutDuration(0))
+	assert.Equal(t, 8*time.Minute, service.lockoutDuration(3))
+	assert.Equal(t, time.Hour, service.lockoutDuration(10))
+}
+
+func TestUnlock_Success(t *testing.T) {
+	service, mockRepo := newLockoutTestService(&redisMocks.MockRedisClient{})
+
+	usr := lockoutTestUser(0, 2)
+	lockedUntil := time.Now().UTC().Add(time.Hour)
+	usr.LockedUntil = &lockedUntil
+	mockRepo.On("FindByID", uint(1)).Return(usr, nil)
+	mockRepo.On("UpdateFields", uint(1), map[string]interface{}{
+		"failed_logins": 0,
+		"lockouts":      0,
+		"locked_until":  nil,
+	}).Return(nil)
+	mockRepo.On("CreateLockoutEvent", mock.MatchedBy(func(e *entity.LockoutEvent) bool {
+		return e.UserID == 1 && e.Event == entity.LockoutEventUnlocked && *e.ActorID == 9
+	})).Return(nil)
+
+	err := service.Unlock("1", 9)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUnlock_NotLocked(t *testing.T) {
+	service, mockRepo := newLockoutTestService(&redisMocks.MockRedisClient{})
+
+	mockRepo.On("FindByID", uint(1)).Return(lockoutTestUser(0, 0), nil)
+
+	err := service.Unlock("1", 9)
+
+	assert.Equal(t, "user_not_locked", err.Error())
+	mockRepo.AssertNotCalled(t, "UpdateFields", mock.Anything, mock.Anything)
+}
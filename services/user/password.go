@@ -0,0 +1,220 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"task_mng/domain/user/entity"
+	"task_mng/pkg/apperror"
+	"task_mng/pkg/jwt"
+	"task_mng/pkg/notifier"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ********************* Change Password *********************
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" valid:"required~current_password_is_required" example:"Admin!123"`
+	NewPassword     string `json:"new_password" valid:"required~new_password_is_required,length(8|32)~password_must_be_8_to_32_characters" example:"Admin!456"`
+}
+
+// ChangePassword sets a new password for the user, signs out every other session and revokes the access tokens
+// sessionID is the session the change was made from, it stays signed in
+func (s *Service) ChangePassword(id uint, sessionID string, req *ChangePasswordRequest) error {
+	usr, err := s.repository.FindByID(id)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding user", "error", err)
+			return apperror.Internal(err)
+		}
+		return apperror.NotFound("user_not_found").Wrap(err)
+	}
+
+	if !s.verifyPassword(usr.Password, req.CurrentPassword) {
+		return apperror.Validation("current_password_is_incorrect")
+	}
+
+	if req.NewPassword == req.CurrentPassword {
+		return apperror.Validation("new_password_must_differ")
+	}
+
+	if err := s.setPassword(usr.ID, req.NewPassword, nil); err != nil {
+		return err
+	}
+
+	s.revokeSessions(usr.ID, sessionID)
+	s.revokeAccessTokens(usr.ID)
+
+	return nil
+}
+
+// ********************* Forgot Password *********************
+type ForgotPasswordRequest struct {
+	Email string `json:"email" valid:"required~email_is_required,email~email_is_invalid" example:"admin@xdr.com"`
+}
+
+// ForgotPassword sends a reset token to the user with the email
+// It succeeds for unknown emails too so it can't be used to find out who has an account
+func (s *Service) ForgotPassword(req *ForgotPasswordRequest) error {
+	usr, err := s.repository.FindByEmail(req.Email)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding user", "error", err)
+			return apperror.Internal(err)
+		}
+		s.logger.Info("password reset requested for unknown email")
+		return nil
+	}
+
+	// Only the latest token can be used
+	if err := s.repository.InvalidateResetTokens(usr.ID); err != nil {
+		s.logger.Error("error invalidating reset tokens", "error", err)
+		return apperror.Internal(err)
+	}
+
+	token, err := newToken()
+	if err != nil {
+		s.logger.Error("error generating reset token", "error", err)
+		return apperror.Internal(err)
+	}
+
+	expiresAt := time.Now().UTC().Add(s.config.ResetTokenTTL)
+	err = s.repository.CreateResetToken(&entity.PasswordResetToken{
+		UserID:    usr.ID,
+		TokenHash: hashToken(token),
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		s.logger.Error("error creating reset token", "error", err)
+		return apperror.Internal(err)
+	}
+
+	// A failed delivery isn't reported either, the response has to be the same as for unknown emails
+	err = s.notifier.Notify(context.Background(), notifier.Notification{
+		UserID:  usr.ID,
+		Email:   usr.Email,
+		Subject: "Password reset",
+		Message: fmt.Sprintf("Use the token to reset your password, it expires at %s", expiresAt.Format(time.RFC3339)),
+		Token:   token,
+	})
+	if err != nil {
+		s.logger.Error("error sending reset token", "error", err, "user_id", usr.ID)
+	}
+
+	return nil
+}
+
+// ********************* Reset Password *********************
+type ResetPasswordRequest struct {
+	Token       string `json:"token" valid:"required~token_is_required" example:"3f2a..."`
+	NewPassword string `json:"new_password" valid:"required~new_password_is_required,length(8|32)~password_must_be_8_to_32_characters" example:"Admin!456"`
+}
+
+// ResetPassword sets a new password with a reset token, signs out every session, revokes the access tokens and lifts a lockout
+func (s *Service) ResetPassword(req *ResetPasswordRequest) error {
+	token, err := s.repository.FindResetToken(hashToken(req.Token))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding reset token", "error", err)
+			return apperror.Internal(err)
+		}
+		return apperror.Validation("invalid_reset_token")
+	}
+
+	if !token.IsUsable(time.Now().UTC()) {
+		return apperror.Validation("invalid_reset_token")
+	}
+
+	// Claiming the token first makes sure it can be used only once, even by concurrent requests
+	used, err := s.repository.UseResetToken(token.ID)
+	if err != nil {
+		s.logger.Error("error using reset token", "error", err)
+		return apperror.Internal(err)
+	}
+	if !used {
+		return apperror.Validation("invalid_reset_token")
+	}
+
+	// Proving access to the email is enough to lift a lockout
+	err = s.setPassword(token.UserID, req.NewPassword, map[string]interface{}{
+		"failed_logins": 0,
+		"lockouts":      0,
+		"locked_until":  nil,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.revokeSessions(token.UserID, "")
+	s.revokeAccessTokens(token.UserID)
+
+	return nil
+}
+
+// Helper functions
+
+// setPassword hashes and stores the password of a user along with the extra fields
+func (s *Service) setPassword(id uint, password string, fields map[string]interface{}) error {
+	hashedPassword, err := s.hashPassword(password)
+	if err != nil {
+		return apperror.Internal(err)
+	}
+
+	if fields == nil {
+		fields = map[string]interface{}{}
+	}
+	fields["password"] = hashedPassword
+
+	if err := s.repository.UpdateFields(id, fields); err != nil {
+		s.logger.Error("error updating password", "error", err)
+		return apperror.Internal(err)
+	}
+
+	return nil
+}
+
+// revokeSessions signs out the sessions of the user except keepSessionID
+func (s *Service) revokeSessions(id uint, keepSessionID string) {
+	if s.revoker == nil {
+		return
+	}
+
+	if err := s.revoker.RevokeSessions(context.Background(), fmt.Sprint(id), keepSessionID); err != nil {
+		s.logger.Error("error revoking sessions", "error", err, "user_id", id)
+	}
+}
+
+// revokeAccessTokens revokes the personal access tokens of the user, they were created with the old password
+func (s *Service) revokeAccessTokens(id uint) {
+	if err := s.repository.RevokeAccessTokens(id); err != nil {
+		s.logger.Error("error revoking access tokens", "error", err, "user_id", id)
+	}
+}
+
+// isRevoked reports whether the session of the claims was revoked, an error means it couldn't be checked
+func (s *Service) isRevoked(claims *jwt.Claims) (bool, error) {
+	if s.revoker == nil {
+		return false, nil
+	}
+
+	return s.revoker.IsRevoked(context.Background(), claims)
+}
+
+// newToken generates a random token to send to a user, e.g. to reset their password
+func newToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// hashToken hashes a token for storage, tokens are random so a plain hash is enough
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,277 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"task_mng/domain/user/entity"
+	"task_mng/domain/user/mocks"
+	"task_mng/pkg/jwt"
+	jwtMocks "task_mng/pkg/jwt/mocks"
+	"task_mng/pkg/notifier"
+	notifierMocks "task_mng/pkg/notifier/mocks"
+	redisMocks "task_mng/pkg/redis/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+type revokedSessions struct {
+	userID        string
+	keepSessionID string
+	calls         int
+}
+
+func newPasswordTestService(revoked *revokedSessions, notifierMock *notifierMocks.MockNotifier) (*Service, *mocks.MockUserRepository) {
+	mockRepo := new(mocks.MockUserRepository)
+	revoker := &jwtMocks.MockSessionRevoker{
+		RevokeSessionsFunc: func(ctx context.Context, userID, keepSessionID string) error {
+			revoked.userID = userID
+			revoked.keepSessionID = keepSessionID
+			revoked.calls++
+			return nil
+		},
+	}
+	return New(mockRepo, &jwtMocks.MockJWTManager{}, revoker, &redisMocks.MockRedisClient{}, notifierMock, Config{ResetTokenTTL: time.Hour}), mockRepo
+}
+
+func hasPassword(fields map[string]interface{}, password string) bool {
+	hashed, ok := fields["password"].(string)
+	return ok && bcrypt.CompareHashAndPassword([]byte(hashed), []byte(password)) == nil
+}
+
+func passwordChanged(password string) interface{} {
+	return mock.MatchedBy(func(fields map[string]interface{}) bool { return hasPassword(fields, password) })
+}
+
+// ********************* Change Password Tests *********************
+
+func TestChangePassword_Success(t *testing.T) {
+	revoked := &revokedSessions{}
+	service, mockRepo := newPasswordTestService(revoked, &notifierMocks.MockNotifier{})
+
+	mockRepo.On("FindByID", uint(1)).Return(lockoutTestUser(0, 0), nil)
+	mockRepo.On("UpdateFields", uint(1), passwordChanged("new_password")).Return(nil)
+	mockRepo.On("RevokeAccessTokens", uint(1)).Return(nil)
+
+	err := service.ChangePassword(1, "current-session", &ChangePasswordRequest{
+		CurrentPassword: "correct_password",
+		NewPassword:     "new_password",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "1", revoked.userID)
+	assert.Equal(t, "current-session", revoked.keepSessionID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestChangePassword_WrongCurrentPassword(t *testing.T) {
+	revoked := &revokedSessions{}
+	service, mockRepo := newPasswordTestService(revoked, &notifierMocks.MockNotifier{})
+
+	mockRepo.On("FindByID", uint(1)).Return(lockoutTestUser(0, 0), nil)
+
+	err := service.ChangePassword(1, "current-session", &ChangePasswordRequest{
+		CurrentPassword: "wrong_password",
+		NewPassword:     "new_password",
+	})
+
+	assert.Equal(t, "current_password_is_incorrect", err.Error())
+	assert.Zero(t, revoked.calls)
+	mockRepo.AssertNotCalled(t, "UpdateFields", mock.Anything, mock.Anything)
+}
+
+func TestChangePassword_SamePassword(t *testing.T) {
+	service, mockRepo := newPasswordTestService(&revokedSessions{}, &notifierMocks.MockNotifier{})
+
+	mockRepo.On("FindByID", uint(1)).Return(lockoutTestUser(0, 0), nil)
+
+	err := service.ChangePassword(1, "current-session", &ChangePasswordRequest{
+		CurrentPassword: "correct_password",
+		NewPassword:     "correct_password",
+	})
+
+	assert.Equal(t, "new_password_must_differ", err.Error())
+	mockRepo.AssertNotCalled(t, "UpdateFields", mock.Anything, mock.Anything)
+}
+
+// ********************* Forgot Password Tests *********************
+
+func TestForgotPassword_SendsToken(t *testing.T) {
+	var sent notifier.Notification
+	service, mockRepo := newPasswordTestService(&revokedSessions{}, &notifierMocks.MockNotifier{
+		NotifyFunc: func(ctx context.Context, notification notifier.Notification) error {
+			sent = notification
+			return nil
+		},
+	})
+
+	var stored *entity.PasswordResetToken
+	mockRepo.On("FindByEmail", "user@example.com").Return(lockoutTestUser(0, 0), nil)
+	mockRepo.On("InvalidateResetTokens", uint(1)).Return(nil)
+	mockRepo.On("CreateResetToken", mock.AnythingOfType("*entity.PasswordResetToken")).
+		Run(func(args mock.Arguments) { stored = args.Get(0).(*entity.PasswordResetToken) }).
+		Return(nil)
+
+	err := service.ForgotPassword(&ForgotPasswordRequest{Email: "user@example.com"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), sent.UserID)
+	assert.Equal(t, lockoutTestUser(0, 0).Email, sent.Email)
+	assert.Equal(t, uint(1), stored.UserID)
+	assert.WithinDuration(t, time.Now().UTC().Add(time.Hour), stored.ExpiresAt, time.Minute)
+
+	// Only the hash of the token is stored, and it isn't part of the message notifiers may log
+	token := sent.Token
+	assert.NotEmpty(t, token)
+	assert.NotContains(t, sent.Message, token)
+	assert.NotContains(t, stored.TokenHash, token)
+	assert.Equal(t, hashToken(token), stored.TokenHash)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestForgotPassword_NotifierFailure(t *testing.T) {
+	service, mockRepo := newPasswordTestService(&revokedSessions{}, &notifierMocks.MockNotifier{
+		NotifyFunc: func(ctx context.Context, notification notifier.Notification) error {
+			return errors.New("delivery failed")
+		},
+	})
+
+	mockRepo.On("FindByEmail", "user@example.com").Return(lockoutTestUser(0, 0), nil)
+	mockRepo.On("InvalidateResetTokens", uint(1)).Return(nil)
+	mockRepo.On("CreateResetToken", mock.AnythingOfType("*entity.PasswordResetToken")).Return(nil)
+
+	// The response is the same as for unknown emails
+	err := service.ForgotPassword(&ForgotPasswordRequest{Email: "user@example.com"})
+
+	assert.NoError(t, err)
+}
+
+func TestForgotPassword_UnknownEmail(t *testing.T) {
+	notified := false
+	service, mockRepo := newPasswordTestService(&revokedSessions{}, &notifierMocks.MockNotifier{
+		NotifyFunc: func(ctx context.Context, notification notifier.Notification) error {
+			notified = true
+			return nil
+		},
+	})
+
+	mockRepo.On("FindByEmail", "nobody@example.com").Return(entity.User{}, gorm.ErrRecordNotFound)
+
+	err := service.ForgotPassword(&ForgotPasswordRequest{Email: "nobody@example.com"})
+
+	assert.NoError(t, err)
+	assert.False(t, notified)
+	mockRepo.AssertNotCalled(t, "CreateResetToken", mock.Anything)
+}
+
+// ********************* Reset Password Tests *********************
+
+func TestResetPassword_Success(t *testing.T) {
+	revoked := &revokedSessions{}
+	service, mockRepo := newPasswordTestService(revoked, &notifierMocks.MockNotifier{})
+
+	mockRepo.On("FindResetToken", hashToken("token")).Return(entity.PasswordResetToken{
+		ID:        7,
+		UserID:    1,
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}, nil)
+	mockRepo.On("UseResetToken", uint(7)).Return(true, nil)
+	mockRepo.On("UpdateFields", uint(1), mock.MatchedBy(func(fields map[string]interface{}) bool {
+		return fields["locked_until"] == nil && fields["failed_logins"] == 0 && hasPassword(fields, "new_password")
+	})).Return(nil)
+	// access tokens created by whoever had the old password stop working too
+	mockRepo.On("RevokeAccessTokens", uint(1)).Return(nil)
+
+	err := service.ResetPassword(&ResetPasswordRequest{Token: "token", NewPassword: "new_password"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "1", revoked.userID)
+	assert.Empty(t, revoked.keepSessionID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestResetPassword_ExpiredToken(t *testing.T) {
+	service, mockRepo := newPasswordTestService(&revokedSessions{}, &notifierMocks.MockNotifier{})
+
+	mockRepo.On("FindResetToken", hashToken("token")).Return(entity.PasswordResetToken{
+		ID:        7,
+		UserID:    1,
+		ExpiresAt: time.Now().UTC().Add(-time.Minute),
+	}, nil)
+
+	err := service.ResetPassword(&ResetPasswordRequest{Token: "token", NewPassword: "new_password"})
+
+	assert.Equal(t, "invalid_reset_token", err.Error())
+	mockRepo.AssertNotCalled(t, "UseResetToken", mock.Anything)
+}
+
+func TestResetPassword_TokenAlreadyUsed(t *testing.T) {
+	revoked := &revokedSessions{}
+	service, mockRepo := newPasswordTestService(revoked, &notifierMocks.MockNotifier{})
+
+	mockRepo.On("FindResetToken", hashToken("token")).Return(entity.PasswordResetToken{
+		ID:        7,
+		UserID:    1,
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}, nil)
+	// another request used the token in the meantime
+	mockRepo.On("UseResetToken", uint(7)).Return(false, nil)
+
+	err := service.ResetPassword(&ResetPasswordRequest{Token: "token", NewPassword: "new_password"})
+
+	assert.Equal(t, "invalid_reset_token", err.Error())
+	assert.Zero(t, revoked.calls)
+	mockRepo.AssertNotCalled(t, "UpdateFields", mock.Anything, mock.Anything)
+}
+
+func TestResetPassword_UnknownToken(t *testing.T) {
+	service, mockRepo := newPasswordTestService(&revokedSessions{}, &notifierMocks.MockNotifier{})
+
+	mockRepo.On("FindResetToken", hashToken("token")).Return(entity.PasswordResetToken{}, gorm.ErrRecordNotFound)
+
+	err := service.ResetPassword(&ResetPasswordRequest{Token: "token", NewPassword: "new_password"})
+
+	assert.Equal(t, "invalid_reset_token", err.Error())
+}
+
+// ********************* Refresh Revocation Tests *********************
+
+func TestRefresh_RevokedSession(t *testing.T) {
+	mockJWT := &jwtMocks.MockJWTManager{
+		GenerateNewTokenPairFunc: func(refreshToken string) (*jwt.TokenPair, error) {
+			t.Fatal("tokens of a revoked session must not be refreshed")
+			return nil, nil
+		},
+	}
+	revoker := &jwtMocks.MockSessionRevoker{
+		IsRevokedFunc: func(ctx context.Context, claims *jwt.Claims) (bool, error) { return true, nil },
+	}
+	service := New(new(mocks.MockUserRepository), mockJWT, revoker, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
+
+	_, err := service.Refresh(&RefreshRequest{RefreshToken: "refresh_token"})
+
+	assert.Equal(t, "session_revoked", err.Error())
+}
+
+func TestRefresh_RevocationCheckFails(t *testing.T) {
+	mockJWT := &jwtMocks.MockJWTManager{
+		GenerateNewTokenPairFunc: func(refreshToken string) (*jwt.TokenPair, error) {
+			t.Fatal("tokens must not be refreshed while revocations can't be checked")
+			return nil, nil
+		},
+	}
+	revoker := &jwtMocks.MockSessionRevoker{
+		IsRevokedFunc: func(ctx context.Context, claims *jwt.Claims) (bool, error) {
+			return false, errors.New("redis unavailable")
+		},
+	}
+	service := New(new(mocks.MockUserRepository), mockJWT, revoker, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
+
+	_, err := service.Refresh(&RefreshRequest{RefreshToken: "refresh_token"})
+
+	assert.Equal(t, "session_check_unavailable", err.Error())
+}
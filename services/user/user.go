@@ -4,24 +4,51 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
+	"strings"
 	"task_mng/domain/user"
 	"task_mng/domain/user/aggregate"
 	"task_mng/domain/user/entity"
+	"task_mng/pkg/apperror"
 	"task_mng/pkg/jwt"
+	"task_mng/pkg/notifier"
+	"task_mng/pkg/redis"
+	"time"
 
 	"github.com/asaskevich/govalidator"
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 )
 
+// Config controls the login lockout, the password reset and the email verification of the service
+type Config struct {
+	Lockout LockoutConfig
+	// ResetTokenTTL is how long a password reset token can be used
+	ResetTokenTTL time.Duration
+	// VerificationTokenTTL is how long an email verification token can be used
+	VerificationTokenTTL time.Duration
+}
+
 type Service struct {
 	repository user.Repository
 	logger     *slog.Logger
 	jwtManager jwt.JWTManager
+	revoker    jwt.SessionRevoker
+	redis      redis.RedisClient
+	notifier   notifier.Notifier
+	config     Config
 }
 
-func New(repository user.Repository, jwtManager jwt.JWTManager) *Service {
-	return &Service{repository: repository, logger: slog.Default(), jwtManager: jwtManager}
+func New(repository user.Repository, jwtManager jwt.JWTManager, revoker jwt.SessionRevoker, redis redis.RedisClient, notifier notifier.Notifier, config Config) *Service {
+	return &Service{
+		repository: repository,
+		logger:     slog.Default(),
+		jwtManager: jwtManager,
+		revoker:    revoker,
+		redis:      redis,
+		notifier:   notifier,
+		config:     config,
+	}
 }
 
 // ********************* Create *********************
@@ -37,30 +64,41 @@ func (s *Service) Create(req *CreateRequest) error {
 	if err != nil {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
 			s.logger.Error("error finding user", "error", err)
-			return err
+			return apperror.Internal(err)
 		}
 	}
 
 	if user.ID != 0 {
 		s.logger.Error("user already exists")
-		return errors.New("user_already_exists")
+		return apperror.Conflict("user_already_exists")
+	}
+
+	if err := s.checkEmailAvailable(req.Email, 0); err != nil {
+		return err
 	}
 
 	hashedPassword, err := s.hashPassword(req.Password)
 	if err != nil {
 		s.logger.Error("error hashing password", "error", err)
-		return errors.New("internal_server_error")
+		return apperror.Internal(err)
 	}
 
-	err = s.repository.Create(&entity.User{
+	usr := &entity.User{
 		Username: req.Username,
 		FullName: req.FullName,
 		Email:    req.Email,
 		Password: hashedPassword,
-	})
+		Role:     entity.RoleUser,
+	}
+	err = s.repository.Create(usr)
 	if err != nil {
 		s.logger.Error("error creating user", "error", err)
-		return errors.New("internal_server_error")
+		return apperror.Internal(err)
+	}
+
+	// The user can ask for another verification if this one doesn't arrive
+	if err := s.sendVerification(usr); err != nil {
+		s.logger.Error("error sending email verification", "error", err, "user_id", usr.ID)
 	}
 
 	return nil
@@ -70,26 +108,40 @@ func (s *Service) Create(req *CreateRequest) error {
 type LoginRequest struct {
 	Username string `json:"username" valid:"required~username_is_required,length(3|20)~username_must_be_3_to_20_characters" example:"admin"`
 	Password string `json:"password" valid:"required~password_is_required,length(8|32)~password_must_be_8_to_32_characters" example:"Admin!123"`
+	// IP is the address of the client, failed logins are tracked per IP
+	IP string `json:"-"`
 }
 
 func (s *Service) Login(req *LoginRequest) (*aggregate.AuthResponse, error) {
+	if err := s.checkIP(req.IP); err != nil {
+		return nil, err
+	}
+
 	user, err := s.repository.FindByUsername(req.Username)
 	if err != nil {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
 			s.logger.Error("error finding user", "error", err)
-			return nil, errors.New("username_or_password_is_incorrect")
+			return nil, apperror.Unauthorized("username_or_password_is_incorrect")
 		}
 	}
 
+	// The password of a locked account isn't checked so it can't be guessed in the meantime
+	if user.IsLocked(time.Now().UTC()) {
+		s.logger.Warn("login to locked user", "user_id", user.ID, "ip", req.IP)
+		return nil, accountLocked(*user.LockedUntil)
+	}
+
 	if !s.verifyPassword(user.Password, req.Password) {
 		s.logger.Error("password is incorrect")
-		return nil, errors.New("username_or_password_is_incorrect")
+		return nil, s.recordFailedLogin(&user, req.IP)
 	}
 
-	tokens, err := s.jwtManager.GenerateTokenPair(fmt.Sprint(user.ID), user.Email, user.Username, "")
+	s.resetFailedLogins(&user)
+
+	tokens, err := s.jwtManager.GenerateTokenPair(fmt.Sprint(user.ID), user.Email, user.Username, user.Role)
 	if err != nil {
 		s.logger.Error("error generating token pair", "error", err)
-		return nil, errors.New("internal_server_error")
+		return nil, apperror.Internal(err)
 	}
 
 	return &aggregate.AuthResponse{
@@ -104,10 +156,27 @@ type RefreshRequest struct {
 }
 
 func (s *Service) Refresh(req *RefreshRequest) (*aggregate.AuthResponse, error) {
+	claims, err := s.jwtManager.ValidateRefreshToken(req.RefreshToken)
+	if err != nil {
+		s.logger.Error("error validating refresh token", "error", err)
+		return nil, refreshTokenError(err)
+	}
+
+	// Sessions revoked by a password change or reset can't be refreshed either
+	revoked, err := s.isRevoked(claims)
+	if err != nil {
+		s.logger.Error("error checking session revocation", "error", err)
+		return nil, apperror.New(http.StatusServiceUnavailable, "session_check_unavailable").Wrap(err)
+	}
+	if revoked {
+		s.logger.Warn("refresh of revoked session", "user_id", claims.UserID)
+		return nil, apperror.Unauthorized("session_revoked")
+	}
+
 	tokens, err := s.jwtManager.GenerateNewTokenPair(req.RefreshToken)
 	if err != nil {
 		s.logger.Error("error generating new token pair", "error", err)
-		return nil, err
+		return nil, refreshTokenError(err)
 	}
 
 	return &aggregate.AuthResponse{
@@ -121,10 +190,10 @@ func (s *Service) FindByID(id uint) (*aggregate.UserResponse, error) {
 	if err != nil {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
 			s.logger.Error("error finding user", "error", err)
-			return nil, err
+			return nil, apperror.Internal(err)
 		}
 		s.logger.Error("error finding user", "error", err)
-		return nil, err
+		return nil, apperror.NotFound("user_not_found").Wrap(err)
 	}
 	return aggregate.NewUserResponse(&usr), nil
 }
@@ -138,20 +207,29 @@ type UpdateProfileRequest struct {
 func (s *Service) UpdateProfile(id uint, req *UpdateProfileRequest) (*aggregate.UserResponse, error) {
 	if _, err := govalidator.ValidateStruct(req); err != nil {
 		s.logger.Error("error validating request", "error", err)
-		return nil, err
+		return nil, apperror.Validation(err.Error())
 	}
 
 	usr, err := s.repository.FindByID(id)
 	if err != nil {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
 			s.logger.Error("error finding user", "error", err)
-			return nil, fmt.Errorf("internal_server_error")
+			return nil, apperror.Internal(err)
 		}
 	}
 
 	if usr.ID == 0 {
 		s.logger.Error("user not found")
-		return nil, fmt.Errorf("user_not_found")
+		return nil, apperror.NotFound("user_not_found")
+	}
+
+	// A new email has to be verified again
+	emailChanged := !strings.EqualFold(usr.Email, req.Email)
+	if emailChanged {
+		if err := s.checkEmailAvailable(req.Email, usr.ID); err != nil {
+			return nil, err
+		}
+		usr.EmailVerifiedAt = nil
 	}
 
 	usr.FullName = req.FullName
@@ -159,7 +237,13 @@ func (s *Service) UpdateProfile(id uint, req *UpdateProfileRequest) (*aggregate.
 	err = s.repository.Update(usr)
 	if err != nil {
 		s.logger.Error("error updating user", "error", err)
-		return nil, fmt.Errorf("internal_server_error")
+		return nil, apperror.Internal(err)
+	}
+
+	if emailChanged {
+		if err := s.sendVerification(&usr); err != nil {
+			s.logger.Error("error sending email verification", "error", err, "user_id", usr.ID)
+		}
 	}
 
 	return aggregate.NewUserResponse(&usr), nil
@@ -170,7 +254,7 @@ func (s *Service) FindAll(page, limit int) (*aggregate.UserListResponse, error)
 	users, count, err := s.repository.FindAll(page, limit)
 	if err != nil {
 		s.logger.Error("error finding users", "error", err)
-		return nil, err
+		return nil, apperror.Internal(err)
 	}
 
 	return aggregate.NewUserListResponse(users, page, limit, count, ""), nil
@@ -186,6 +270,13 @@ func (s *Service) hashPassword(password string) (string, error) {
 	return string(hashedPassword), nil
 }
 
+func refreshTokenError(err error) error {
+	if errors.Is(err, jwt.ErrExpiredToken) {
+		return apperror.Unauthorized("refresh_token_expired").Wrap(err)
+	}
+	return apperror.Unauthorized("invalid_refresh_token").Wrap(err)
+}
+
 func (s *Service) verifyPassword(hashedPassword, password string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
 	if err != nil {
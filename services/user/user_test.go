@@ -5,6 +5,8 @@ import (
 	"task_mng/domain/user/entity"
 	"task_mng/domain/user/mocks"
 	jwtMocks "task_mng/pkg/jwt/mocks"
+	notifierMocks "task_mng/pkg/notifier/mocks"
+	redisMocks "task_mng/pkg/redis/mocks"
 	"testing"
 	"time"
 
@@ -21,7 +23,7 @@ import (
 func TestCreate_Success(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	req := &CreateRequest{
 		Username: "user",
@@ -33,14 +35,24 @@ func TestCreate_Success(t *testing.T) {
 	// Mock: user not found (new user)
 	mockRepo.On("FindByUsername", req.Username).Return(entity.User{}, gorm.ErrRecordNotFound)
 
+	// Mock: email not in use
+	mockRepo.On("FindByEmail", req.Email).Return(entity.User{}, gorm.ErrRecordNotFound)
+
 	// Mock: create user
 	mockRepo.On("Create", mock.MatchedBy(func(u *entity.User) bool {
 		return u.Username == req.Username &&
 			u.FullName == req.FullName &&
 			u.Email == req.Email &&
+			u.Role == entity.RoleUser &&
 			u.Password != "" // password should be hashed
 	})).Return(nil)
 
+	// Mock: send email verification
+	mockRepo.On("InvalidateVerificationTokens", mock.Anything).Return(nil)
+	mockRepo.On("CreateVerificationToken", mock.MatchedBy(func(e *entity.EmailVerificationToken) bool {
+		return e.Email == req.Email
+	})).Return(nil)
+
 	err := service.Create(req)
 
 	assert.NoError(t, err)
@@ -50,7 +62,7 @@ func TestCreate_Success(t *testing.T) {
 func TestCreate_UserAlreadyExists(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	req := &CreateRequest{
 		Username: "user",
@@ -76,7 +88,7 @@ func TestCreate_UserAlreadyExists(t *testing.T) {
 func TestCreate_RepositoryFindError(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	req := &CreateRequest{
 		Username: "user",
@@ -91,14 +103,15 @@ func TestCreate_RepositoryFindError(t *testing.T) {
 	err := service.Create(req)
 
 	assert.Error(t, err)
-	assert.Equal(t, dbError, err)
+	assert.ErrorIs(t, err, dbError)
+	assert.Equal(t, "internal_server_error", err.Error())
 	mockRepo.AssertExpectations(t)
 }
 
 func TestCreate_CreateError(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	req := &CreateRequest{
 		Username: "user",
@@ -108,6 +121,7 @@ func TestCreate_CreateError(t *testing.T) {
 	}
 
 	mockRepo.On("FindByUsername", req.Username).Return(entity.User{}, gorm.ErrRecordNotFound)
+	mockRepo.On("FindByEmail", req.Email).Return(entity.User{}, gorm.ErrRecordNotFound)
 
 	createError := errors.New("create error")
 	mockRepo.On("Create", mock.Anything).Return(createError)
@@ -124,7 +138,7 @@ func TestCreate_CreateError(t *testing.T) {
 func TestLogin_Success(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	password := "Password!123"
 	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
@@ -139,6 +153,7 @@ func TestLogin_Success(t *testing.T) {
 		FullName: "User",
 		Email:    "user@example.com",
 		Password: string(hashedPassword),
+		Role:     entity.RoleAdmin,
 	}
 
 	req := &LoginRequest{
@@ -158,6 +173,7 @@ func TestLogin_Success(t *testing.T) {
 		assert.Equal(t, "1", userID)
 		assert.Equal(t, existingUser.Email, email)
 		assert.Equal(t, existingUser.Username, username)
+		assert.Equal(t, entity.RoleAdmin, role)
 		return expectedTokens, nil
 	}
 
@@ -176,7 +192,7 @@ func TestLogin_Success(t *testing.T) {
 func TestLogin_UserNotFound(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	req := &LoginRequest{
 		Username: "user",
@@ -196,7 +212,7 @@ func TestLogin_UserNotFound(t *testing.T) {
 func TestLogin_InvalidPassword(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("correct_password"), bcrypt.DefaultCost)
 
@@ -224,7 +240,7 @@ func TestLogin_InvalidPassword(t *testing.T) {
 func TestLogin_TokenGenerationError(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	password := "Password!123"
 	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
@@ -257,7 +273,7 @@ func TestLogin_TokenGenerationError(t *testing.T) {
 func TestLogin_RepositoryError(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	req := &LoginRequest{
 		Username: "user",
@@ -280,7 +296,7 @@ func TestLogin_RepositoryError(t *testing.T) {
 func TestRefresh_Success(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	req := &RefreshRequest{
 		RefreshToken: "valid_refresh_token",
@@ -309,7 +325,7 @@ func TestRefresh_Success(t *testing.T) {
 func TestRefresh_InvalidToken(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	req := &RefreshRequest{
 		RefreshToken: "invalid_refresh_token",
@@ -323,13 +339,14 @@ func TestRefresh_InvalidToken(t *testing.T) {
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
-	assert.Equal(t, jwt.ErrInvalidToken, err)
+	assert.ErrorIs(t, err, jwt.ErrInvalidToken)
+	assert.Equal(t, "invalid_refresh_token", err.Error())
 }
 
 func TestRefresh_ExpiredToken(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	req := &RefreshRequest{
 		RefreshToken: "expired_refresh_token",
@@ -343,7 +360,8 @@ func TestRefresh_ExpiredToken(t *testing.T) {
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
-	assert.Equal(t, jwt.ErrExpiredToken, err)
+	assert.ErrorIs(t, err, jwt.ErrExpiredToken)
+	assert.Equal(t, "refresh_token_expired", err.Error())
 }
 
 // ********************* FindByID Tests *********************
@@ -351,7 +369,7 @@ func TestRefresh_ExpiredToken(t *testing.T) {
 func TestFindByID_Success(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	expectedUser := entity.User{
 		Model: gorm.Model{
@@ -381,7 +399,7 @@ func TestFindByID_Success(t *testing.T) {
 func TestFindByID_NotFound(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	mockRepo.On("FindByID", uint(999)).Return(entity.User{}, gorm.ErrRecordNotFound)
 
@@ -389,7 +407,8 @@ func TestFindByID_NotFound(t *testing.T) {
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
-	assert.Equal(t, gorm.ErrRecordNotFound, err)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.Equal(t, "user_not_found", err.Error())
 
 	mockRepo.AssertExpectations(t)
 }
@@ -397,7 +416,7 @@ func TestFindByID_NotFound(t *testing.T) {
 func TestFindByID_RepositoryError(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	dbError := errors.New("database error")
 	mockRepo.On("FindByID", uint(1)).Return(entity.User{}, dbError)
@@ -406,7 +425,8 @@ func TestFindByID_RepositoryError(t *testing.T) {
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
-	assert.Equal(t, dbError, err)
+	assert.ErrorIs(t, err, dbError)
+	assert.Equal(t, "internal_server_error", err.Error())
 
 	mockRepo.AssertExpectations(t)
 }
@@ -416,8 +436,9 @@ func TestFindByID_RepositoryError(t *testing.T) {
 func TestUpdateProfile_Success(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
+	verifiedAt := time.Now()
 	existingUser := entity.User{
 		Model: gorm.Model{
 			ID:        1,
@@ -427,6 +448,8 @@ func TestUpdateProfile_Success(t *testing.T) {
 		Username: "user",
 		FullName: "User",
 		Email:    "user@example.com",
+		// the new email has to be verified again
+		EmailVerifiedAt: &verifiedAt,
 	}
 
 	req := &UpdateProfileRequest{
@@ -435,10 +458,16 @@ func TestUpdateProfile_Success(t *testing.T) {
 	}
 
 	mockRepo.On("FindByID", uint(1)).Return(existingUser, nil)
+	mockRepo.On("FindByEmail", req.Email).Return(entity.User{}, gorm.ErrRecordNotFound)
 	mockRepo.On("Update", mock.MatchedBy(func(u entity.User) bool {
 		return u.ID == 1 &&
 			u.FullName == req.FullName &&
-			u.Email == req.Email
+			u.Email == req.Email &&
+			u.EmailVerifiedAt == nil
+	})).Return(nil)
+	mockRepo.On("InvalidateVerificationTokens", uint(1)).Return(nil)
+	mockRepo.On("CreateVerificationToken", mock.MatchedBy(func(e *entity.EmailVerificationToken) bool {
+		return e.UserID == 1 && e.Email == req.Email
 	})).Return(nil)
 
 	result, err := service.UpdateProfile(1, req)
@@ -455,7 +484,7 @@ func TestUpdateProfile_Success(t *testing.T) {
 func TestUpdateProfile_ValidationError(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	req := &UpdateProfileRequest{
 		FullName: "User",
@@ -471,7 +500,7 @@ func TestUpdateProfile_ValidationError(t *testing.T) {
 func TestUpdateProfile_UserNotFound(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	req := &UpdateProfileRequest{
 		FullName: "New Name",
@@ -492,7 +521,7 @@ func TestUpdateProfile_UserNotFound(t *testing.T) {
 func TestUpdateProfile_UpdateError(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	existingUser := entity.User{
 		Model:    gorm.Model{ID: 1},
@@ -508,6 +537,7 @@ func TestUpdateProfile_UpdateError(t *testing.T) {
 
 	updateError := errors.New("update error")
 	mockRepo.On("FindByID", uint(1)).Return(existingUser, nil)
+	mockRepo.On("FindByEmail", req.Email).Return(entity.User{}, gorm.ErrRecordNotFound)
 	mockRepo.On("Update", mock.Anything).Return(updateError)
 
 	result, err := service.UpdateProfile(1, req)
@@ -522,7 +552,7 @@ func TestUpdateProfile_UpdateError(t *testing.T) {
 func TestUpdateProfile_FindByIDError(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	req := &UpdateProfileRequest{
 		FullName: "New User",
@@ -546,7 +576,7 @@ func TestUpdateProfile_FindByIDError(t *testing.T) {
 func TestHashPassword(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	password := "Password!123"
 	hashedPassword, err := service.hashPassword(password)
@@ -563,7 +593,7 @@ func TestHashPassword(t *testing.T) {
 func TestVerifyPassword_Success(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	password := "Password!123"
 	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
@@ -575,7 +605,7 @@ func TestVerifyPassword_Success(t *testing.T) {
 func TestVerifyPassword_Failure(t *testing.T) {
 	mockRepo := new(mocks.MockUserRepository)
 	mockJWT := &jwtMocks.MockJWTManager{}
-	service := New(mockRepo, mockJWT)
+	service := New(mockRepo, mockJWT, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, &notifierMocks.MockNotifier{}, Config{})
 
 	password := "Password!123"
 	wrongPassword := "wrong_password"
@@ -0,0 +1,149 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"task_mng/domain/user/entity"
+	"task_mng/pkg/apperror"
+	"task_mng/pkg/notifier"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ********************* Verify Email *********************
+type VerifyEmailRequest struct {
+	Token string `json:"token" valid:"required~token_is_required" example:"3f2a..."`
+}
+
+// VerifyEmail marks the email the token was sent to as verified
+// Tokens sent to an address the user has changed since can't be used anymore
+func (s *Service) VerifyEmail(req *VerifyEmailRequest) error {
+	token, err := s.repository.FindVerificationToken(hashToken(req.Token))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding verification token", "error", err)
+			return apperror.Internal(err)
+		}
+		return apperror.Validation("invalid_verification_token")
+	}
+
+	if !token.IsUsable(time.Now().UTC()) {
+		return apperror.Validation("invalid_verification_token")
+	}
+
+	usr, err := s.repository.FindByID(token.UserID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding user", "error", err)
+			return apperror.Internal(err)
+		}
+		return apperror.Validation("invalid_verification_token")
+	}
+
+	if !strings.EqualFold(usr.Email, token.Email) {
+		return apperror.Validation("invalid_verification_token")
+	}
+
+	// Claiming the token first makes sure it can be used only once, even by concurrent requests
+	used, err := s.repository.UseVerificationToken(token.ID)
+	if err != nil {
+		s.logger.Error("error using verification token", "error", err)
+		return apperror.Internal(err)
+	}
+	if !used {
+		return apperror.Validation("invalid_verification_token")
+	}
+
+	err = s.repository.UpdateFields(usr.ID, map[string]interface{}{"email_verified_at": time.Now().UTC()})
+	if err != nil {
+		s.logger.Error("error verifying email", "error", err)
+		return apperror.Internal(err)
+	}
+
+	return nil
+}
+
+// ********************* Resend Verification *********************
+
+// ResendVerification sends a new verification token to the user, the previous ones can't be used anymore
+func (s *Service) ResendVerification(id uint) error {
+	usr, err := s.repository.FindByID(id)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding user", "error", err)
+			return apperror.Internal(err)
+		}
+		return apperror.NotFound("user_not_found").Wrap(err)
+	}
+
+	if usr.IsEmailVerified() {
+		return apperror.Conflict("email_already_verified")
+	}
+
+	if err := s.sendVerification(&usr); err != nil {
+		s.logger.Error("error sending email verification", "error", err, "user_id", usr.ID)
+		return apperror.Internal(err)
+	}
+
+	return nil
+}
+
+// Helper functions
+
+// checkEmailAvailable makes sure no other user than userID has the email, regardless of case
+func (s *Service) checkEmailAvailable(email string, userID uint) error {
+	usr, err := s.repository.FindByEmail(email)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding user", "error", err)
+			return apperror.Internal(err)
+		}
+		return nil
+	}
+
+	if usr.ID != userID {
+		return apperror.Conflict("email_already_in_use")
+	}
+
+	return nil
+}
+
+// sendVerification sends a verification token for the current email of the user
+// Without a notifier, e.g. when the default user is seeded, there is nothing to send
+func (s *Service) sendVerification(usr *entity.User) error {
+	if s.notifier == nil {
+		return nil
+	}
+
+	// Only the latest token can be used
+	if err := s.repository.InvalidateVerificationTokens(usr.ID); err != nil {
+		return err
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return err
+	}
+
+	expiresAt := time.Now().UTC().Add(s.config.VerificationTokenTTL)
+	err = s.repository.CreateVerificationToken(&entity.EmailVerificationToken{
+		UserID:    usr.ID,
+		Email:     usr.Email,
+		TokenHash: hashToken(token),
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.notifier.Notify(context.Background(), notifier.Notification{
+		UserID:  usr.ID,
+		Email:   usr.Email,
+		Subject: "Verify your email",
+		Message: fmt.Sprintf("Use the token to verify %s, it expires at %s", usr.Email, expiresAt.Format(time.RFC3339)),
+		Token:   token,
+	})
+}
@@ -0,0 +1,189 @@
+package user
+
+import (
+	"context"
+	"task_mng/domain/user/entity"
+	"task_mng/domain/user/mocks"
+	jwtMocks "task_mng/pkg/jwt/mocks"
+	"task_mng/pkg/notifier"
+	notifierMocks "task_mng/pkg/notifier/mocks"
+	redisMocks "task_mng/pkg/redis/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func newVerificationTestService(notifierMock *notifierMocks.MockNotifier) (*Service, *mocks.MockUserRepository) {
+	mockRepo := new(mocks.MockUserRepository)
+	return New(mockRepo, &jwtMocks.MockJWTManager{}, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, notifierMock, Config{VerificationTokenTTL: time.Hour}), mockRepo
+}
+
+func verificationTestUser(email string) entity.User {
+	return entity.User{Model: gorm.Model{ID: 1}, Username: "user", Email: email}
+}
+
+// ********************* Email Uniqueness Tests *********************
+
+func TestUpdateProfile_EmailInUse(t *testing.T) {
+	service, mockRepo := newVerificationTestService(&notifierMocks.MockNotifier{})
+
+	mockRepo.On("FindByID", uint(1)).Return(verificationTestUser("user@example.com"), nil)
+	mockRepo.On("FindByEmail", "Other@Example.com").Return(entity.User{Model: gorm.Model{ID: 2}, Email: "other@example.com"}, nil)
+
+	_, err := service.UpdateProfile(1, &UpdateProfileRequest{FullName: "User", Email: "Other@Example.com"})
+
+	assert.Equal(t, "email_already_in_use", err.Error())
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestUpdateProfile_SameEmailKeepsVerification(t *testing.T) {
+	service, mockRepo := newVerificationTestService(&notifierMocks.MockNotifier{})
+
+	verifiedAt := time.Now()
+	usr := verificationTestUser("user@example.com")
+	usr.EmailVerifiedAt = &verifiedAt
+
+	mockRepo.On("FindByID", uint(1)).Return(usr, nil)
+	mockRepo.On("Update", mock.MatchedBy(func(u entity.User) bool {
+		return u.FullName == "New User" && u.EmailVerifiedAt != nil
+	})).Return(nil)
+
+	// only the case of the email changes
+	_, err := service.UpdateProfile(1, &UpdateProfileRequest{FullName: "New User", Email: "User@example.com"})
+
+	assert.NoError(t, err)
+	mockRepo.AssertNotCalled(t, "FindByEmail", mock.Anything)
+	mockRepo.AssertNotCalled(t, "CreateVerificationToken", mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreate_EmailInUse(t *testing.T) {
+	service, mockRepo := newVerificationTestService(&notifierMocks.MockNotifier{})
+
+	mockRepo.On("FindByUsername", "user").Return(entity.User{}, gorm.ErrRecordNotFound)
+	mockRepo.On("FindByEmail", "USER@example.com").Return(verificationTestUser("user@example.com"), nil)
+
+	err := service.Create(&CreateRequest{Username: "user", FullName: "User", Email: "USER@example.com", Password: "Password!123"})
+
+	assert.Equal(t, "email_already_in_use", err.Error())
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestCreate_WithoutNotifier(t *testing.T) {
+	mockRepo := new(mocks.MockUserRepository)
+	service := New(mockRepo, &jwtMocks.MockJWTManager{}, &jwtMocks.MockSessionRevoker{}, &redisMocks.MockRedisClient{}, nil, Config{})
+
+	mockRepo.On("FindByUsername", "admin").Return(entity.User{}, gorm.ErrRecordNotFound)
+	mockRepo.On("FindByEmail", "admin@example.com").Return(entity.User{}, gorm.ErrRecordNotFound)
+	mockRepo.On("Create", mock.AnythingOfType("*entity.User")).Return(nil)
+
+	// The default user is seeded without a notifier, no token is created for it
+	err := service.Create(&CreateRequest{Username: "admin", FullName: "Admin", Email: "admin@example.com", Password: "Admin!123"})
+
+	assert.NoError(t, err)
+	mockRepo.AssertNotCalled(t, "InvalidateVerificationTokens", mock.Anything)
+	mockRepo.AssertNotCalled(t, "CreateVerificationToken", mock.Anything)
+}
+
+// ********************* Verify Email Tests *********************
+
+func TestVerifyEmail_Success(t *testing.T) {
+	service, mockRepo := newVerificationTestService(&notifierMocks.MockNotifier{})
+
+	mockRepo.On("FindVerificationToken", hashToken("token")).Return(entity.EmailVerificationToken{
+		ID:        7,
+		UserID:    1,
+		Email:     "user@example.com",
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}, nil)
+	mockRepo.On("FindByID", uint(1)).Return(verificationTestUser("user@example.com"), nil)
+	mockRepo.On("UseVerificationToken", uint(7)).Return(true, nil)
+	mockRepo.On("UpdateFields", uint(1), mock.MatchedBy(func(fields map[string]interface{}) bool {
+		_, ok := fields["email_verified_at"].(time.Time)
+		return ok
+	})).Return(nil)
+
+	err := service.VerifyEmail(&VerifyEmailRequest{Token: "token"})
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestVerifyEmail_EmailChangedSince(t *testing.T) {
+	service, mockRepo := newVerificationTestService(&notifierMocks.MockNotifier{})
+
+	mockRepo.On("FindVerificationToken", hashToken("token")).Return(entity.EmailVerificationToken{
+		ID:        7,
+		UserID:    1,
+		Email:     "old@example.com",
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}, nil)
+	mockRepo.On("FindByID", uint(1)).Return(verificationTestUser("new@example.com"), nil)
+
+	err := service.VerifyEmail(&VerifyEmailRequest{Token: "token"})
+
+	assert.Equal(t, "invalid_verification_token", err.Error())
+	mockRepo.AssertNotCalled(t, "UseVerificationToken", mock.Anything)
+}
+
+func TestVerifyEmail_ExpiredToken(t *testing.T) {
+	service, mockRepo := newVerificationTestService(&notifierMocks.MockNotifier{})
+
+	mockRepo.On("FindVerificationToken", hashToken("token")).Return(entity.EmailVerificationToken{
+		ID:        7,
+		UserID:    1,
+		Email:     "user@example.com",
+		ExpiresAt: time.Now().UTC().Add(-time.Minute),
+	}, nil)
+
+	err := service.VerifyEmail(&VerifyEmailRequest{Token: "token"})
+
+	assert.Equal(t, "invalid_verification_token", err.Error())
+	mockRepo.AssertNotCalled(t, "FindByID", mock.Anything)
+}
+
+// ********************* Resend Verification Tests *********************
+
+func TestResendVerification_Success(t *testing.T) {
+	var sent notifier.Notification
+	service, mockRepo := newVerificationTestService(&notifierMocks.MockNotifier{
+		NotifyFunc: func(ctx context.Context, notification notifier.Notification) error {
+			sent = notification
+			return nil
+		},
+	})
+
+	var stored *entity.EmailVerificationToken
+	mockRepo.On("FindByID", uint(1)).Return(verificationTestUser("user@example.com"), nil)
+	mockRepo.On("InvalidateVerificationTokens", uint(1)).Return(nil)
+	mockRepo.On("CreateVerificationToken", mock.AnythingOfType("*entity.EmailVerificationToken")).
+		Run(func(args mock.Arguments) { stored = args.Get(0).(*entity.EmailVerificationToken) }).
+		Return(nil)
+
+	err := service.ResendVerification(1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), sent.UserID)
+	assert.Equal(t, "user@example.com", sent.Email)
+	assert.Equal(t, "user@example.com", stored.Email)
+	assert.NotContains(t, sent.Message, sent.Token)
+	assert.Equal(t, hashToken(sent.Token), stored.TokenHash)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestResendVerification_AlreadyVerified(t *testing.T) {
+	service, mockRepo := newVerificationTestService(&notifierMocks.MockNotifier{})
+
+	verifiedAt := time.Now()
+	usr := verificationTestUser("user@example.com")
+	usr.EmailVerifiedAt = &verifiedAt
+	mockRepo.On("FindByID", uint(1)).Return(usr, nil)
+
+	err := service.ResendVerification(1)
+
+	assert.Equal(t, "email_already_verified", err.Error())
+	mockRepo.AssertNotCalled(t, "CreateVerificationToken", mock.Anything)
+}
@@ -0,0 +1,276 @@
+package worklog
+
+import (
+	"errors"
+	"log/slog"
+	"strconv"
+	taskR "task_mng/domain/task"
+	taskEntity "task_mng/domain/task/entity"
+	"task_mng/domain/user"
+	"task_mng/domain/worklog"
+	"task_mng/domain/worklog/aggregate"
+	"task_mng/domain/worklog/entity"
+	"task_mng/pkg/apperror"
+	"task_mng/services/task"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	dateLayout = "2006-01-02"
+	// maxTimesheetDays bounds the period of a timesheet report
+	maxTimesheetDays = 366
+)
+
+type Service struct {
+	repository     worklog.Repository
+	logger         *slog.Logger
+	taskRepository taskR.Repository
+	taskService    *task.Service
+	userRepository user.Repository
+	now            func() time.Time
+}
+
+func New(repository worklog.Repository, taskRepository taskR.Repository, taskService *task.Service, userRepository user.Repository) *Service {
+	return &Service{
+		repository:     repository,
+		logger:         slog.Default(),
+		taskRepository: taskRepository,
+		taskService:    taskService,
+		userRepository: userRepository,
+		now:            time.Now,
+	}
+}
+
+// ********************* Create *********************
+type CreateRequest struct {
+	Minutes int    `json:"minutes" valid:"required~minutes_is_required" example:"90"`
+	Date    string `json:"date" example:"2025-01-01"` // defaults to today
+	Note    string `json:"note" example:"Investigated the failing job"`
+	// Overrides the automatically reduced remaining estimate of the task
+	RemainingEstimate *int `json:"remaining_estimate" example:"120"`
+}
+
+// Create logs work on a task for the user and updates the time tracking of the task
+// Unless given, the remaining estimate is reduced by the logged time
+func (s *Service) Create(taskID string, userID uint, req *CreateRequest) (*aggregate.WorklogResponse, error) {
+	t, err := s.findTask(taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Minutes <= 0 {
+		return nil, apperror.Validation("minutes_must_be_positive")
+	}
+
+	if req.RemainingEstimate != nil && *req.RemainingEstimate < 0 {
+		return nil, apperror.Validation("invalid_estimate")
+	}
+
+	date := truncateDay(s.now())
+	if req.Date != "" {
+		date, err = time.Parse(dateLayout, req.Date)
+		if err != nil {
+			return nil, apperror.Validation("invalid_date")
+		}
+	}
+
+	e := &entity.Worklog{
+		TaskID:  t.ID,
+		UserID:  userID,
+		Minutes: req.Minutes,
+		Date:    date,
+		Note:    req.Note,
+	}
+
+	err = s.repository.Create(e)
+	if err != nil {
+		s.logger.Error("error creating worklog", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	s.syncTimeSpent(t.ID, req.Minutes, req.RemainingEstimate)
+
+	username := ""
+	if u, err := s.userRepository.FindByID(userID); err == nil {
+		username = u.Username
+	}
+
+	return aggregate.NewWorklogResponse(e, username), nil
+}
+
+// ********************* Find All *********************
+func (s *Service) FindAll(taskID string) ([]*aggregate.WorklogResponse, error) {
+	t, err := s.findTask(taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	worklogs, err := s.repository.FindByTaskID(t.ID)
+	if err != nil {
+		s.logger.Error("error finding worklogs", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewWorklogListResponse(worklogs, s.usernames(worklogs)), nil
+}
+
+// ********************* Delete *********************
+// Delete removes a work log of the user, the remaining estimate is left as is
+func (s *Service) Delete(taskID, id string, userID uint) error {
+	t, err := s.findTask(taskID)
+	if err != nil {
+		return err
+	}
+
+	uintID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		s.logger.Error("error parsing id", "error", err)
+		return apperror.BadRequest("invalid_id")
+	}
+
+	e, err := s.repository.FindByID(uint(uintID))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding worklog", "error", err)
+			return apperror.Internal(err)
+		}
+		s.logger.Error("worklog not found", "error", err)
+		return apperror.NotFound("worklog_not_found")
+	}
+
+	if e.TaskID != t.ID {
+		s.logger.Error("worklog belongs to another task", "worklog_id", e.ID, "task_id", t.ID)
+		return apperror.NotFound("worklog_not_found")
+	}
+
+	if e.UserID != userID {
+		return apperror.Forbidden("worklog_belongs_to_another_user")
+	}
+
+	err = s.repository.Delete(e)
+	if err != nil {
+		s.logger.Error("error deleting worklog", "error", err)
+		return apperror.Internal(err)
+	}
+
+	s.syncTimeSpent(t.ID, 0, nil)
+
+	return nil
+}
+
+// ********************* Timesheet *********************
+type TimesheetRequest struct {
+	User *string `form:"user"`
+	From string  `form:"from"`
+	To   string  `form:"to"`
+}
+
+// Timesheet reports the logged time per user, day and task for a period
+// The period defaults to the current week (Monday to Sunday)
+func (s *Service) Timesheet(req *TimesheetRequest) (*aggregate.TimesheetResponse, error) {
+	today := truncateDay(s.now())
+	from := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+	to := from.AddDate(0, 0, 6)
+
+	var err error
+	if req.From != "" {
+		from, err = time.Parse(dateLayout, req.From)
+		if err != nil {
+			return nil, apperror.Validation("invalid_from_date")
+		}
+	}
+
+	if req.To != "" {
+		to, err = time.Parse(dateLayout, req.To)
+		if err != nil {
+			return nil, apperror.Validation("invalid_to_date")
+		}
+	}
+
+	if to.Before(from) {
+		return nil, apperror.Validation("to_must_not_be_before_from")
+	}
+
+	if to.Sub(from) > maxTimesheetDays*24*time.Hour {
+		return nil, apperror.Validation("timesheet_period_too_long")
+	}
+
+	var userID *uint
+	if req.User != nil && *req.User != "" {
+		u, err := s.userRepository.FindByUsername(*req.User)
+		if err != nil {
+			s.logger.Error("error finding user", "error", err)
+			return nil, apperror.Validation("user_not_found")
+		}
+		userID = &u.ID
+	}
+
+	worklogs, err := s.repository.FindByPeriod(userID, from, to)
+	if err != nil {
+		s.logger.Error("error finding worklogs", "error", err)
+		return nil, apperror.Internal(err)
+	}
+
+	return aggregate.NewTimesheetResponse(from, to, worklogs, s.usernames(worklogs)), nil
+}
+
+// Helper functions
+func (s *Service) findTask(taskID string) (taskEntity.Task, error) {
+	uintID, err := strconv.ParseUint(taskID, 10, 32)
+	if err != nil {
+		s.logger.Error("error parsing id", "error", err)
+		return taskEntity.Task{}, apperror.BadRequest("invalid_id")
+	}
+
+	t, err := s.taskRepository.FindByID(uint(uintID))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("error finding task", "error", err)
+			return taskEntity.Task{}, apperror.Internal(err)
+		}
+		s.logger.Error("task not found", "error", err)
+		return taskEntity.Task{}, apperror.NotFound("task_not_found")
+	}
+
+	return t, nil
+}
+
+// syncTimeSpent recomputes the logged time of a task from its work logs and takes the newly logged
+// minutes off the remaining estimate, unless a new remaining estimate is given
+// The work log is already saved, so a failure is only logged, the next change of the task's work logs fixes the logged time
+func (s *Service) syncTimeSpent(taskID uint, logged int, remaining *int) {
+	if err := s.taskService.UpdateTimeTracking(taskID, logged, remaining); err != nil {
+		s.logger.Error("error syncing time spent", "task_id", taskID, "error", err)
+	}
+}
+
+func (s *Service) usernames(worklogs []entity.Worklog) map[uint]string {
+	userIDs := make([]uint, 0)
+	userIDMap := make(map[uint]bool)
+	for _, worklog := range worklogs {
+		if !userIDMap[worklog.UserID] {
+			userIDs = append(userIDs, worklog.UserID)
+			userIDMap[worklog.UserID] = true
+		}
+	}
+
+	usernames := make(map[uint]string)
+	if len(userIDs) > 0 {
+		users, err := s.userRepository.FindByIDs(userIDs)
+		if err != nil {
+			s.logger.Warn("error finding worklog users", "error", err)
+		} else {
+			for _, user := range users {
+				usernames[user.ID] = user.Username
+			}
+		}
+	}
+
+	return usernames
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
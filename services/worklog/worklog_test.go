@@ -0,0 +1,170 @@
+package worklog
+
+import (
+	"errors"
+	projectMocks "task_mng/domain/project/mocks"
+	taskEntity "task_mng/domain/task/entity"
+	taskMocks "task_mng/domain/task/mocks"
+	userEntity "task_mng/domain/user/entity"
+	userMocks "task_mng/domain/user/mocks"
+	"task_mng/domain/worklog/entity"
+	"task_mng/domain/worklog/mocks"
+	notifierMocks "task_mng/pkg/notifier/mocks"
+	redisMocks "task_mng/pkg/redis/mocks"
+	storageMocks "task_mng/pkg/storage/mocks"
+	"task_mng/services/task"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func newTestService() (*Service, *mocks.MockWorklogRepository, *taskMocks.MockTaskRepository, *userMocks.MockUserRepository) {
+	mockRepo := new(mocks.MockWorklogRepository)
+	mockTaskRepo := new(taskMocks.MockTaskRepository)
+	mockUserRepo := new(userMocks.MockUserRepository)
+
+	// Mock CountByStatus for metrics updates of the task service
+	mockTaskRepo.On("CountByStatus").Return(map[taskEntity.Status]int64{}, nil)
+
+	taskService := task.New(mockTaskRepo, &redisMocks.MockRedisClient{}, mockUserRepo, &notifierMocks.MockNotifier{}, new(projectMocks.MockProjectRepository), &storageMocks.MockStorage{})
+
+	service := New(mockRepo, mockTaskRepo, taskService, mockUserRepo)
+	service.now = func() time.Time { return time.Date(2025, time.January, 8, 15, 0, 0, 0, time.UTC) } // Wednesday
+
+	return service, mockRepo, mockTaskRepo, mockUserRepo
+}
+
+func day(d int) time.Time {
+	return time.Date(2025, time.January, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCreate_ReducesRemainingEstimate(t *testing.T) {
+	service, mockRepo, mockTaskRepo, mockUserRepo := newTestService()
+
+	mockTaskRepo.On("FindByID", uint(1)).Return(taskEntity.Task{
+		Model:             gorm.Model{ID: 1},
+		OriginalEstimate:  480,
+		RemainingEstimate: 300,
+		TimeSpent:         180,
+	}, nil)
+	mockRepo.On("Create", mock.MatchedBy(func(e *entity.Worklog) bool {
+		return e.TaskID == 1 && e.UserID == 2 && e.Minutes == 90 && e.Date.Equal(day(8))
+	})).Return(nil)
+	mockTaskRepo.On("UpdateTimeTracking", uint(1), 90, (*int)(nil)).Return(nil)
+	mockUserRepo.On("FindByID", uint(2)).Return(userEntity.User{Model: gorm.Model{ID: 2}, Username: "john"}, nil)
+
+	result, err := service.Create("1", 2, &CreateRequest{Minutes: 90})
+
+	require.NoError(t, err)
+	assert.Equal(t, "2025-01-08", result.Date)
+	assert.Equal(t, "john", result.User.Username)
+	mockRepo.AssertExpectations(t)
+	mockTaskRepo.AssertExpectations(t)
+}
+
+func TestCreate_RemainingEstimateOverride(t *testing.T) {
+	service, mockRepo, mockTaskRepo, mockUserRepo := newTestService()
+
+	mockTaskRepo.On("FindByID", uint(1)).Return(taskEntity.Task{Model: gorm.Model{ID: 1}, RemainingEstimate: 60}, nil)
+	mockRepo.On("Create", mock.AnythingOfType("*entity.Worklog")).Return(nil)
+	remaining := 240
+	mockTaskRepo.On("UpdateTimeTracking", uint(1), 120, &remaining).Return(nil)
+	mockUserRepo.On("FindByID", uint(2)).Return(userEntity.User{Model: gorm.Model{ID: 2}, Username: "john"}, nil)
+
+	_, err := service.Create("1", 2, &CreateRequest{Minutes: 120, Date: "2025-01-06", RemainingEstimate: &remaining})
+
+	require.NoError(t, err)
+	mockTaskRepo.AssertExpectations(t)
+}
+
+func TestCreate_TimeTrackingFails(t *testing.T) {
+	service, mockRepo, mockTaskRepo, mockUserRepo := newTestService()
+
+	mockTaskRepo.On("FindByID", uint(1)).Return(taskEntity.Task{Model: gorm.Model{ID: 1}}, nil)
+	mockRepo.On("Create", mock.AnythingOfType("*entity.Worklog")).Return(nil)
+	mockTaskRepo.On("UpdateTimeTracking", uint(1), 30, (*int)(nil)).Return(errors.New("connection reset"))
+	mockUserRepo.On("FindByID", uint(2)).Return(userEntity.User{Model: gorm.Model{ID: 2}, Username: "john"}, nil)
+
+	// The work log is saved, it isn't reported as failed
+	result, err := service.Create("1", 2, &CreateRequest{Minutes: 30})
+
+	require.NoError(t, err)
+	assert.NotNil(t, result)
+	mockTaskRepo.AssertExpectations(t)
+}
+
+func TestCreate_InvalidDate(t *testing.T) {
+	service, mockRepo, mockTaskRepo, _ := newTestService()
+
+	mockTaskRepo.On("FindByID", uint(1)).Return(taskEntity.Task{Model: gorm.Model{ID: 1}}, nil)
+
+	result, err := service.Create("1", 2, &CreateRequest{Minutes: 30, Date: "08/01/2025"})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "invalid_date", err.Error())
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestDelete_OtherUsersWorklog(t *testing.T) {
+	service, mockRepo, mockTaskRepo, _ := newTestService()
+
+	mockTaskRepo.On("FindByID", uint(1)).Return(taskEntity.Task{Model: gorm.Model{ID: 1}}, nil)
+	mockRepo.On("FindByID", uint(5)).Return(entity.Worklog{Model: gorm.Model{ID: 5}, TaskID: 1, UserID: 3}, nil)
+
+	err := service.Delete("1", "5", 2)
+
+	assert.Error(t, err)
+	assert.Equal(t, "worklog_belongs_to_another_user", err.Error())
+	mockRepo.AssertNotCalled(t, "Delete", mock.Anything)
+}
+
+func TestTimesheet_DefaultsToCurrentWeek(t *testing.T) {
+	service, mockRepo, _, mockUserRepo := newTestService()
+
+	mockRepo.On("FindByPeriod", (*uint)(nil), day(6), day(12)).Return([]entity.Worklog{
+		{TaskID: 1, UserID: 2, Minutes: 60, Date: day(6)},
+		{TaskID: 2, UserID: 2, Minutes: 30, Date: day(6)},
+		{TaskID: 1, UserID: 2, Minutes: 45, Date: day(7)},
+		{TaskID: 1, UserID: 3, Minutes: 120, Date: day(8)},
+	}, nil)
+	mockUserRepo.On("FindByIDs", []uint{2, 3}).Return([]userEntity.User{
+		{Model: gorm.Model{ID: 2}, Username: "john"},
+		{Model: gorm.Model{ID: 3}, Username: "jane"},
+	}, nil)
+
+	result, err := service.Timesheet(&TimesheetRequest{})
+
+	require.NoError(t, err)
+	assert.Equal(t, "2025-01-06", result.From)
+	assert.Equal(t, "2025-01-12", result.To)
+	assert.Equal(t, 255, result.TotalMinutes)
+	require.Len(t, result.Users, 2)
+
+	john := result.Users[0]
+	assert.Equal(t, "john", john.User.Username)
+	assert.Equal(t, 135, john.TotalMinutes)
+	require.Len(t, john.Days, 2)
+	assert.Equal(t, 90, john.Days[0].Minutes)
+	assert.Equal(t, 45, john.Days[1].Minutes)
+	require.Len(t, john.Tasks, 2)
+	assert.Equal(t, 105, john.Tasks[0].Minutes)
+	assert.Equal(t, 30, john.Tasks[1].Minutes)
+
+	assert.Equal(t, 120, result.Users[1].TotalMinutes)
+}
+
+func TestTimesheet_InvalidPeriod(t *testing.T) {
+	service, mockRepo, _, _ := newTestService()
+
+	result, err := service.Timesheet(&TimesheetRequest{From: "2025-01-10", To: "2025-01-01"})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "to_must_not_be_before_from", err.Error())
+	mockRepo.AssertNotCalled(t, "FindByPeriod", mock.Anything, mock.Anything, mock.Anything)
+}